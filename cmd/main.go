@@ -58,7 +58,13 @@ func RunPessimism(_ *cli.Context) error {
 	logging.New(cfg.Environment)
 	logger := logging.WithContext(ctx)
 
-	ss := state.NewMemState()
+	ss, closeState, err := state.New(ctx, cfg.StateConfig)
+	if err != nil {
+		logger.Fatal("Error creating state store", zap.Error(err))
+		return err
+	}
+	defer closeState()
+
 	bundle, err := client.NewBundle(ctx, cfg.ClientConfig)
 	if err != nil {
 		logger.Fatal("Error creating client bundle", zap.Error(err))
@@ -80,6 +86,16 @@ func RunPessimism(_ *cli.Context) error {
 		return err
 	}
 
+	ids, err := pessimism.RestoreSessions()
+	if err != nil {
+		logger.Fatal("Error restoring persisted heuristic sessions", zap.Error(err))
+		return err
+	}
+
+	if len(ids) > 0 {
+		logger.Info("Restored persisted session UUIDs", zap.Any(logging.Session, ids))
+	}
+
 	if cfg.IsBootstrap() {
 		logger.Debug("Bootstrapping application state")
 
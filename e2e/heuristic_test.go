@@ -20,6 +20,7 @@ import (
 
 	op_e2e "github.com/ethereum-optimism/optimism/op-e2e"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -554,3 +555,52 @@ func TestFaultDetector(t *testing.T) {
 	assert.Contains(t, alerts[0].Text, "fault_detector", "expected alert to be for fault_detector")
 	assert.Contains(t, alerts[0].Text, alertMsg, "expected alert to have alert message")
 }
+
+// TestSafeHeadLag ... Tests the E2E flow of a safe head lag heuristic session
+// against a mocked op-node whose safe head stops advancing.
+func TestSafeHeadLag(t *testing.T) {
+	ts := e2e.CreateSysTestSuite(t, "")
+	defer ts.Close()
+
+	alertMsg := "batches are no longer landing on L1"
+
+	// Start with a healthy sync status
+	ts.TestOpNodeSvr.SetSyncStatus(&eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: 100},
+		SafeL2:      eth.L2BlockRef{Number: 95},
+		FinalizedL2: eth.L2BlockRef{Number: 90},
+	})
+
+	ids, err := ts.App.BootStrap([]*models.SessionRequestParams{{
+		Network:       core.Layer2.String(),
+		HeuristicType: core.SafeHeadLag.String(),
+		StartHeight:   nil,
+		EndHeight:     nil,
+		AlertingParams: &core.AlertPolicy{
+			Sev: core.LOW.String(),
+			Msg: alertMsg,
+		},
+		SessionParams: map[string]interface{}{
+			"max_safe_lag": 10,
+		},
+	}})
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	// Stall the safe head
+	ts.TestOpNodeSvr.SetSyncStatus(&eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: 200},
+		SafeL2:      eth.L2BlockRef{Number: 95},
+		FinalizedL2: eth.L2BlockRef{Number: 90},
+	})
+
+	// Wait for Pessimism to process a new L2 header and send a notification to the mocked Slack server.
+	require.NoError(t, wait.For(context.Background(), 500*time.Millisecond, func() (bool, error) {
+		return len(ts.TestSlackSvr.SlackAlerts()) > 0, nil
+	}))
+
+	alerts := ts.TestSlackSvr.SlackAlerts()
+	require.Equal(t, 1, len(alerts), "expected 1 alert")
+	assert.Contains(t, alerts[0].Text, core.SafeHeadLag.String(), "expected alert to be for safe_head_lag")
+	assert.Contains(t, alerts[0].Text, alertMsg, "expected alert to have alert message")
+}
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/client"
 	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 
 	"go.uber.org/zap"
 )
@@ -165,3 +167,89 @@ func (svr *TestSlackServer) SlackAlerts() []*client.SlackPayload {
 func (svr *TestSlackServer) ClearAlerts() {
 	svr.Payloads = []*client.SlackPayload{}
 }
+
+// TestOpNodeServer ... Mock op-node JSON-RPC server for testing L2 sync status heuristics
+type TestOpNodeServer struct {
+	Server *httptest.Server
+	Port   int
+
+	mu     sync.Mutex
+	status *eth.SyncStatus
+}
+
+// rpcRequest ... Minimal JSON-RPC request envelope
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// NewTestOpNodeServer ... Creates a new mock op-node server
+func NewTestOpNodeServer(url string, port int) *TestOpNodeServer {
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", url, port))
+	if err != nil {
+		panic(err)
+	}
+
+	ons := &TestOpNodeServer{
+		status: &eth.SyncStatus{},
+	}
+
+	ons.Server = httptest.NewUnstartedServer(http.HandlerFunc(ons.mockRPC))
+
+	err = ons.Server.Listener.Close()
+	if err != nil {
+		panic(err)
+	}
+	ons.Server.Listener = l
+
+	// get port from listener
+	ons.Port = ons.Server.Listener.Addr().(*net.TCPAddr).Port
+	ons.Server.Start()
+
+	logging.NoContext().Info("Test op-node server started", zap.String("url", url), zap.Int("port", port))
+
+	return ons
+}
+
+// Close ... Closes the server
+func (svr *TestOpNodeServer) Close() {
+	svr.Server.Close()
+}
+
+// URL ... Returns the RPC endpoint of the server
+func (svr *TestOpNodeServer) URL() string {
+	return fmt.Sprintf("http://127.0.0.1:%d", svr.Port)
+}
+
+// SetSyncStatus ... Sets the sync status returned by optimism_syncStatus
+func (svr *TestOpNodeServer) SetSyncStatus(status *eth.SyncStatus) {
+	svr.mu.Lock()
+	defer svr.mu.Unlock()
+
+	svr.status = status
+}
+
+// mockRPC ... Mocks the op-node rollup JSON-RPC API
+func (svr *TestOpNodeServer) mockRPC(w http.ResponseWriter, r *http.Request) {
+	var req rpcRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+
+	switch req.Method {
+	case "optimism_syncStatus":
+		svr.mu.Lock()
+		resp["result"] = svr.status
+		svr.mu.Unlock()
+
+	default:
+		resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -46,6 +46,7 @@ type SysTestSuite struct {
 	// Mocked services
 	TestSlackSvr        *TestSlackServer
 	TestPagerDutyServer *TestPagerDutyServer
+	TestOpNodeSvr       *TestOpNodeServer
 	TestIxClient        *mocks.MockIxClient
 
 	// Clients
@@ -96,6 +97,13 @@ func CreateSysTestSuite(t *testing.T, topicArn string) *SysTestSuite {
 		t.Fatal(err)
 	}
 
+	opNodeServer := NewTestOpNodeServer("127.0.0.1", 0)
+
+	opNodeClient, err := client.NewOpNodeClient(ctx, opNodeServer.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	bundle := &client.Bundle{
 		L1Node:   l1NodeClient,
 		L2Node:   l2NodeClient,
@@ -103,6 +111,7 @@ func CreateSysTestSuite(t *testing.T, topicArn string) *SysTestSuite {
 		L2Client: sys.Clients["sequencer"],
 		L2Geth:   gethClient,
 		IxClient: ixClient,
+		OpNode:   opNodeClient,
 	}
 
 	ctx = app.InitializeContext(ctx, ss, bundle)
@@ -147,11 +156,13 @@ func CreateSysTestSuite(t *testing.T, topicArn string) *SysTestSuite {
 			sys.Close()
 			slackServer.Close()
 			pagerdutyServer.Close()
+			opNodeServer.Close()
 		},
 		AppCfg:              appCfg,
 		Subsystems:          pess.Subsystems,
 		TestSlackSvr:        slackServer,
 		TestPagerDutyServer: pagerdutyServer,
+		TestOpNodeSvr:       opNodeServer,
 		L1Client:            sys.Clients["l1"],
 		L2Client:            sys.Clients["sequencer"],
 		TestIxClient:        ixClient,
@@ -0,0 +1,75 @@
+package alert
+
+import (
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxDelivered ... Max number of delivered block based alerts retained for retraction
+	maxDelivered = 1024
+)
+
+// deliveryKey ... Identifies the heuristic session and block an alert was generated from
+type deliveryKey struct {
+	id   core.UUID
+	hash common.Hash
+}
+
+// deliveryLog ... Bounded record of alerts that were propagated to downstream
+// destinations, used to only retract alerts that weren't suppressed by cool down
+type deliveryLog struct {
+	keys    []deliveryKey
+	entries map[deliveryKey]int
+}
+
+// newDeliveryLog ... Initializer
+func newDeliveryLog() *deliveryLog {
+	return &deliveryLog{
+		keys:    make([]deliveryKey, 0),
+		entries: make(map[deliveryKey]int),
+	}
+}
+
+// Add ... Records a delivered alert that was generated from a known block
+func (dl *deliveryLog) Add(alert core.Alert) {
+	if alert.Orphaned || alert.BlockHash == (common.Hash{}) {
+		return
+	}
+
+	key := deliveryKey{alert.HeuristicID, alert.BlockHash}
+	dl.keys = append(dl.keys, key)
+	dl.entries[key]++
+
+	if len(dl.keys) > maxDelivered {
+		dl.remove(dl.keys[0])
+		dl.keys = dl.keys[1:]
+	}
+}
+
+// Retract ... Consumes a delivered alert matching the retraction,
+// returning false when no such alert was delivered
+func (dl *deliveryLog) Retract(alert core.Alert) bool {
+	key := deliveryKey{alert.HeuristicID, alert.BlockHash}
+	if dl.entries[key] == 0 {
+		return false
+	}
+
+	dl.remove(key)
+	for i, k := range dl.keys {
+		if k == key {
+			dl.keys = append(dl.keys[:i], dl.keys[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// remove ... Decrements the delivered count for a key
+func (dl *deliveryLog) remove(key deliveryKey) {
+	dl.entries[key]--
+	if dl.entries[key] <= 0 {
+		delete(dl.entries, key)
+	}
+}
@@ -39,6 +39,20 @@ const (
 	`
 )
 
+const (
+	// internalAlertType ... Display type of alerts raised by Pessimism itself
+	internalAlertType = "internal"
+)
+
+// alertType ... Returns the display type of an alert
+func alertType(a core.Alert) string {
+	if a.Internal {
+		return internalAlertType
+	}
+
+	return a.HT.String()
+}
+
 type Interpolator struct{}
 
 func NewInterpolator() *Interpolator {
@@ -48,7 +62,7 @@ func NewInterpolator() *Interpolator {
 func (*Interpolator) SlackMessage(a core.Alert, msg string) string {
 	return fmt.Sprintf(SlackMsgFmt,
 		a.Sev.Symbol(),
-		a.HT.String(),
+		alertType(a),
 		a.Net.String(),
 		cases.Title(language.English).String(a.Sev.String()),
 		a.HeuristicID.String(),
@@ -58,7 +72,7 @@ func (*Interpolator) SlackMessage(a core.Alert, msg string) string {
 
 func (*Interpolator) PagerDutyMessage(a core.Alert) string {
 	return fmt.Sprintf(PagerDutyMsgFmt,
-		a.HT.String(),
+		alertType(a),
 		a.Net.String(),
 		a.Content)
 }
@@ -19,3 +19,15 @@ func TestPagerDutyMessage(t *testing.T) {
 	actual := new(alert.Interpolator).PagerDutyMessage(a)
 	assert.Equal(t, expected, actual)
 }
+
+func TestInternalAlertMessage(t *testing.T) {
+	a := core.Alert{
+		Internal: true,
+		Net:      core.Layer1,
+		Content:  "Test alert",
+	}
+
+	expected := "\n\tHeuristic Triggered: internal\n\tNetwork: layer1\n\tAssessment: \n\tTest alert\n\t"
+	actual := new(alert.Interpolator).PagerDutyMessage(a)
+	assert.Equal(t, expected, actual)
+}
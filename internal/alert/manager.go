@@ -14,9 +14,20 @@ import (
 	"go.uber.org/zap"
 )
 
+// internalPolicy ... Alert policy applied to alerts raised by Pessimism itself.
+// Internal alerts are keyed by the UUID of the affected resource for cool downs
+var internalPolicy = &core.AlertPolicy{
+	Sev:      core.HIGH.String(),
+	Msg:      "Pessimism is unable to reliably monitor the affected resource",
+	CoolDown: 300,
+}
+
 // Manager ... Interface for alert manager
 type Manager interface {
 	AddSession(core.UUID, *core.AlertPolicy) error
+	RemoveSession(core.UUID) error
+	UpdateSession(core.UUID, *core.AlertPolicy) error
+	GetAlertPolicy(core.UUID) (*core.AlertPolicy, error)
 	Transit() chan core.Alert
 
 	core.Subsystem
@@ -40,6 +51,7 @@ type alertManager struct {
 	store        Store
 	interpolator *Interpolator
 	cdHandler    CoolDownHandler
+	delivered    *deliveryLog
 	cm           RoutingDirectory
 
 	logger       *zap.Logger
@@ -58,6 +70,7 @@ func NewManager(ctx context.Context, cfg *Config, cm RoutingDirectory) Manager {
 	am := &alertManager{
 		ctx:          ctx,
 		cdHandler:    NewCoolDownHandler(),
+		delivered:    newDeliveryLog(),
 		cfg:          cfg,
 		cm:           cm,
 		cancel:       cancel,
@@ -76,6 +89,21 @@ func (am *alertManager) AddSession(id core.UUID, policy *core.AlertPolicy) error
 	return am.store.AddAlertPolicy(id, policy)
 }
 
+// RemoveSession ... Removes a heuristic session from the alert manager store
+func (am *alertManager) RemoveSession(id core.UUID) error {
+	return am.store.RemoveAlertPolicy(id)
+}
+
+// UpdateSession ... Replaces the alert policy of a heuristic session
+func (am *alertManager) UpdateSession(id core.UUID, policy *core.AlertPolicy) error {
+	return am.store.UpdateAlertPolicy(id, policy)
+}
+
+// GetAlertPolicy ... Returns the alert policy of a heuristic session
+func (am *alertManager) GetAlertPolicy(id core.UUID) (*core.AlertPolicy, error) {
+	return am.store.GetAlertPolicy(id)
+}
+
 // Transit ... Returns inter-subsystem transit channel for receiving alerts
 // TODO - Rename this to ingress()
 func (am *alertManager) Transit() chan core.Alert {
@@ -187,14 +215,22 @@ func (am *alertManager) EventLoop() error {
 		case alert := <-am.alertTransit: // Upstream alert
 
 			// 1. Fetch alert policy
-			policy, err := am.store.GetAlertPolicy(alert.HeuristicID)
+			policy, err := am.alertPolicy(alert)
 			if err != nil {
 				am.logger.Error("Could not determine alerting destination", zap.Error(err))
 				continue
 			}
 
 			// 2. Check if alert is in cool down
-			if policy.HasCoolDown() && am.cdHandler.IsCoolDown(alert.HeuristicID) {
+			// NOTE - Orphaned alerts retract earlier alerts and are only
+			// suppressed when the retracted alert was never delivered
+			if alert.Orphaned && !am.delivered.Retract(alert) {
+				am.logger.Debug("Retracted alert was never delivered",
+					zap.String(logging.UUID, alert.HeuristicID.String()))
+				continue
+			}
+
+			if !alert.Orphaned && policy.HasCoolDown() && am.cdHandler.IsCoolDown(alert.HeuristicID) {
 				am.logger.Debug("Alert is in cool down",
 					zap.String(logging.UUID, alert.HeuristicID.String()))
 				continue
@@ -205,15 +241,25 @@ func (am *alertManager) EventLoop() error {
 				zap.String(logging.UUID, alert.HeuristicID.String()))
 
 			am.HandleAlert(alert, policy)
+			am.delivered.Add(alert)
 
 			// 4. Add alert to cool down if applicable
-			if policy.HasCoolDown() {
+			if !alert.Orphaned && policy.HasCoolDown() {
 				am.cdHandler.Add(alert.HeuristicID, time.Duration(policy.CoolDown)*time.Second)
 			}
 		}
 	}
 }
 
+// alertPolicy ... Returns the alert policy for an alert
+func (am *alertManager) alertPolicy(alert core.Alert) (*core.AlertPolicy, error) {
+	if alert.Internal {
+		return internalPolicy, nil
+	}
+
+	return am.store.GetAlertPolicy(alert.HeuristicID)
+}
+
 // HandleAlert ... Handles the alert propagation logic
 func (am *alertManager) HandleAlert(alert core.Alert, policy *core.AlertPolicy) {
 	alert.Sev = policy.Severity()
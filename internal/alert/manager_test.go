@@ -12,6 +12,7 @@ import (
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/mocks"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -238,6 +239,135 @@ func TestEventLoop(t *testing.T) {
 				time.Sleep(1 * time.Second)
 			},
 		},
+		{
+			name:        "Test internal alert",
+			description: "Test internal alert sends to high sev destinations without a session and respects cool down",
+			test: func(t *testing.T) {
+				cm := alert.NewRoutingDirectory(cfg.AlertConfig)
+				sns := mocks.NewMockSNSClient(c)
+				am := alert.NewManager(ctx, cfg.AlertConfig, cm)
+
+				go func() {
+					_ = am.EventLoop()
+				}()
+
+				defer func() {
+					_ = am.Shutdown()
+				}()
+
+				ingress := am.Transit()
+
+				cm.SetSlackClients([]client.SlackClient{mocks.NewMockSlackClient(c)}, core.HIGH)
+				cm.SetPagerDutyClients([]client.PagerDutyClient{mocks.NewMockPagerDutyClient(c)}, core.HIGH)
+				cm.SetSNSClient(sns)
+
+				// Only the first alert should be propagated given the cool down
+				for _, cli := range cm.GetPagerDutyClients(core.HIGH) {
+					pdc, ok := cli.(*mocks.MockPagerDutyClient)
+					assert.True(t, ok)
+
+					pdc.EXPECT().PostEvent(gomock.Any(), gomock.Any()).Return(
+						&client.AlertAPIResponse{
+							Message: "test",
+							Status:  core.SuccessStatus,
+						}, nil).Times(1)
+				}
+
+				for _, cli := range cm.GetSlackClients(core.HIGH) {
+					sc, ok := cli.(*mocks.MockSlackClient)
+					assert.True(t, ok)
+					sc.EXPECT().PostEvent(gomock.Any(), gomock.Any()).Return(
+						&client.AlertAPIResponse{
+							Message: "test",
+							Status:  core.SuccessStatus,
+						}, nil).Times(1)
+				}
+
+				sns.EXPECT().PostEvent(gomock.Any(), gomock.Any()).Return(
+					&client.AlertAPIResponse{
+						Message: "test",
+						Status:  core.SuccessStatus,
+					}, nil).AnyTimes()
+
+				sns.EXPECT().GetName().AnyTimes()
+
+				alert := core.Alert{
+					Internal:    true,
+					HeuristicID: core.NewUUID(),
+					Content:     "path is lagging",
+				}
+
+				ingress <- alert
+				time.Sleep(1 * time.Second)
+				ingress <- alert
+				time.Sleep(1 * time.Second)
+			},
+		},
+		{
+			name:        "Test orphaned alert",
+			description: "Test retractions are only sent for alerts that weren't suppressed by cool down",
+			test: func(t *testing.T) {
+				cm := alert.NewRoutingDirectory(cfg.AlertConfig)
+				sns := mocks.NewMockSNSClient(c)
+				am := alert.NewManager(ctx, cfg.AlertConfig, cm)
+
+				go func() {
+					_ = am.EventLoop()
+				}()
+
+				defer func() {
+					_ = am.Shutdown()
+				}()
+
+				ingress := am.Transit()
+
+				cm.SetSlackClients([]client.SlackClient{mocks.NewMockSlackClient(c)}, core.LOW)
+				cm.SetSNSClient(sns)
+
+				id := core.NewUUID()
+				policy := &core.AlertPolicy{
+					Sev:      core.LOW.String(),
+					Msg:      "test",
+					CoolDown: 60,
+				}
+
+				err := am.AddSession(id, policy)
+				assert.Nil(t, err)
+
+				// Only the delivered alert and its retraction should be propagated
+				for _, cli := range cm.GetSlackClients(core.LOW) {
+					sc, ok := cli.(*mocks.MockSlackClient)
+					assert.True(t, ok)
+					sc.EXPECT().PostEvent(gomock.Any(), gomock.Any()).Return(
+						&client.AlertAPIResponse{
+							Message: "test",
+							Status:  core.SuccessStatus,
+						}, nil).Times(2)
+				}
+
+				sns.EXPECT().PostEvent(gomock.Any(), gomock.Any()).Return(
+					&client.AlertAPIResponse{
+						Message: "test",
+						Status:  core.SuccessStatus,
+					}, nil).AnyTimes()
+
+				sns.EXPECT().GetName().AnyTimes()
+
+				delivered := core.Alert{HeuristicID: id, BlockHash: common.HexToHash("0x01")}
+				suppressed := core.Alert{HeuristicID: id, BlockHash: common.HexToHash("0x02")}
+
+				ingress <- delivered
+				ingress <- suppressed
+
+				suppressed.Orphaned = true
+				ingress <- suppressed
+
+				delivered.Orphaned = true
+				ingress <- delivered
+				ingress <- delivered
+				time.Sleep(1 * time.Second)
+			},
+		},
 	}
 
 	for i, test := range tests {
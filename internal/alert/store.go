@@ -12,6 +12,8 @@ import (
 type Store interface {
 	AddAlertPolicy(core.UUID, *core.AlertPolicy) error
 	GetAlertPolicy(id core.UUID) (*core.AlertPolicy, error)
+	RemoveAlertPolicy(id core.UUID) error
+	UpdateAlertPolicy(id core.UUID, policy *core.AlertPolicy) error
 }
 
 // store ... Alert store implementation
@@ -47,3 +49,23 @@ func (am *store) GetAlertPolicy(id core.UUID) (*core.AlertPolicy, error) {
 
 	return dest, nil
 }
+
+// RemoveAlertPolicy ... Removes the alert policy for the given heuristic UUID
+func (am *store) RemoveAlertPolicy(id core.UUID) error {
+	if _, exists := am.defMap[id]; !exists {
+		return fmt.Errorf("alert destination does not exist for heuristic %s", id.String())
+	}
+
+	delete(am.defMap, id)
+	return nil
+}
+
+// UpdateAlertPolicy ... Replaces the alert policy for the given heuristic UUID
+func (am *store) UpdateAlertPolicy(id core.UUID, policy *core.AlertPolicy) error {
+	if _, exists := am.defMap[id]; !exists {
+		return fmt.Errorf("alert destination does not exist for heuristic %s", id.String())
+	}
+
+	am.defMap[id] = policy
+	return nil
+}
@@ -55,6 +55,62 @@ func TestStore(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name:        "Test Remove Alert Policy Success",
+			description: "Test removal of an existing Alert Policy",
+			testLogic: func(t *testing.T) {
+				am := alert.NewStore()
+
+				id := core.UUID{}
+				policy := &core.AlertPolicy{
+					Dest: core.Slack.String(),
+				}
+
+				err := am.AddAlertPolicy(id, policy)
+				assert.NoError(t, err)
+
+				err = am.RemoveAlertPolicy(id)
+				assert.NoError(t, err)
+
+				_, err = am.GetAlertPolicy(id)
+				assert.Error(t, err)
+
+				// remove again
+				err = am.RemoveAlertPolicy(id)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:        "Test Update Alert Policy Success",
+			description: "Test replacement of an existing Alert Policy",
+			testLogic: func(t *testing.T) {
+				am := alert.NewStore()
+
+				id := core.UUID{}
+				policy := &core.AlertPolicy{
+					Dest: core.Slack.String(),
+				}
+
+				// update before add
+				err := am.UpdateAlertPolicy(id, policy)
+				assert.Error(t, err)
+
+				err = am.AddAlertPolicy(id, policy)
+				assert.NoError(t, err)
+
+				updated := &core.AlertPolicy{
+					Dest:     core.Slack.String(),
+					CoolDown: 60,
+				}
+
+				err = am.UpdateAlertPolicy(id, updated)
+				assert.NoError(t, err)
+
+				actualPolicy, err := am.GetAlertPolicy(id)
+				assert.NoError(t, err)
+				assert.Equal(t, updated, actualPolicy)
+			},
+		},
 		{
 			name:        "Test NewStore",
 			description: "Test NewStore logic",
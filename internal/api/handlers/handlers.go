@@ -6,14 +6,22 @@ import (
 
 	pess_middleware "github.com/base-org/pessimism/internal/api/handlers/middleware"
 	"github.com/base-org/pessimism/internal/api/service"
+	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/go-chi/chi"
 	chi_middleware "github.com/go-chi/chi/middleware"
+	"github.com/google/uuid"
 )
 
 type Handlers interface {
 	HealthCheck(w http.ResponseWriter, r *http.Request)
 	RunHeuristic(w http.ResponseWriter, r *http.Request)
+	StopHeuristic(w http.ResponseWriter, r *http.Request)
+
+	GetHeuristics(w http.ResponseWriter, r *http.Request)
+	GetHeuristic(w http.ResponseWriter, r *http.Request)
+	GetPaths(w http.ResponseWriter, r *http.Request)
+	GetPath(w http.ResponseWriter, r *http.Request)
 
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
@@ -30,6 +38,10 @@ type Route = string
 const (
 	healthRoute    = "/health"
 	heuristicRoute = "/v0/heuristic"
+	sessionRoute   = "/v0/heuristic/{id}"
+	sessionsRoute  = "/v0/heuristics"
+	pathRoute      = "/v0/paths/{id}"
+	pathsRoute     = "/v0/paths"
 )
 
 // New ... Initializer
@@ -43,6 +55,11 @@ func New(ctx context.Context, service service.Service) (Handlers, error) {
 
 	registerEndpoint(healthRoute, router.Get, handlers.HealthCheck)
 	registerEndpoint(heuristicRoute, router.Post, handlers.RunHeuristic)
+	registerEndpoint(sessionRoute, router.Delete, handlers.StopHeuristic)
+	registerEndpoint(sessionRoute, router.Get, handlers.GetHeuristic)
+	registerEndpoint(sessionsRoute, router.Get, handlers.GetHeuristics)
+	registerEndpoint(pathRoute, router.Get, handlers.GetPath)
+	registerEndpoint(pathsRoute, router.Get, handlers.GetPaths)
 
 	handlers.router = router
 
@@ -59,3 +76,13 @@ func registerEndpoint(endpoint string, routeMethod func(pattern string, handlerF
 	handlerFunc func(w http.ResponseWriter, r *http.Request)) {
 	routeMethod(endpoint, http.HandlerFunc(handlerFunc).ServeHTTP)
 }
+
+// parseID ... Parses the UUID provided in the request route
+func parseID(r *http.Request) (core.UUID, error) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		return core.UUID{}, err
+	}
+
+	return core.UUID{UUID: id}, nil
+}
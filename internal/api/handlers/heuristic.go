@@ -40,3 +40,33 @@ func (ph *PessimismHandler) RunHeuristic(w http.ResponseWriter, r *http.Request)
 
 	renderHeuristicResponse(w, r, models.NewSessionAcceptedResp(id))
 }
+
+// StopHeuristic ... Handle heuristic session stop request
+func (ph *PessimismHandler) StopHeuristic(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		logging.WithContext(ph.ctx).
+			Error("Could not parse heuristic session ID", zap.Error(err))
+
+		renderHeuristicResponse(w, r, models.NewSessionInvalidIDResp())
+		return
+	}
+
+	if _, err := ph.service.GetSession(id); err != nil {
+		logging.WithContext(ph.ctx).
+			Debug("Could not find heuristic session", zap.Error(err))
+
+		renderHeuristicResponse(w, r, models.NewSessionNotFoundResp())
+		return
+	}
+
+	if err := ph.service.StopHeuristicSession(id); err != nil {
+		logging.WithContext(ph.ctx).
+			Error("Could not stop heuristic session", zap.Error(err))
+
+		renderHeuristicResponse(w, r, models.NewSessionNoProcessResp())
+		return
+	}
+
+	renderHeuristicResponse(w, r, models.NewSessionStoppedResp(id))
+}
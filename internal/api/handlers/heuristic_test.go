@@ -149,3 +149,160 @@ func TestHeuristicRequest(t *testing.T) {
 	}
 
 }
+
+func TestStopHeuristicRequest(t *testing.T) {
+	id := core.NewUUID()
+
+	var tests = []struct {
+		name        string
+		description string
+		function    string
+
+		constructionLogic func() testSuite
+		testLogic         func(*testing.T, testSuite)
+	}{
+		{
+			name:        "Invalid Session ID",
+			description: "When provided a malformed session ID, an invalid ID response should be returned",
+			function:    "StopHeuristic",
+
+			constructionLogic: func() testSuite {
+				return createTestSuite(t)
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				w := httptest.NewRecorder()
+				r := httptest.NewRequest(http.MethodDelete, "/v0/heuristic/0x42", nil)
+
+				ts.testHandler.ServeHTTP(w, r)
+				res := w.Result()
+
+				data, err := io.ReadAll(res.Body)
+				assert.NoError(t, err)
+
+				actualResp := &models.SessionResponse{}
+				err = json.Unmarshal(data, actualResp)
+
+				assert.NoError(t, err)
+				assert.Equal(t, models.NewSessionInvalidIDResp(), actualResp)
+			},
+		},
+		{
+			name:        "Unknown Session ID",
+			description: "When provided the ID of an unknown session, a not found response should be returned",
+			function:    "StopHeuristic",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetSession(id).
+					Return(nil, fmt.Errorf("not found")).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				w := httptest.NewRecorder()
+				r := httptest.NewRequest(http.MethodDelete, "/v0/heuristic/"+id.String(), nil)
+
+				ts.testHandler.ServeHTTP(w, r)
+				res := w.Result()
+				assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				assert.NoError(t, err)
+
+				actualResp := &models.SessionResponse{}
+				err = json.Unmarshal(data, actualResp)
+
+				assert.NoError(t, err)
+				assert.Equal(t, models.NewSessionNotFoundResp(), actualResp)
+			},
+		},
+		{
+			name:        "Stop Heuristic Failure",
+			description: "When an internal error occurs, a failed processing response should be returned",
+			function:    "StopHeuristic",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetSession(id).
+					Return(&models.SessionSummary{}, nil).
+					Times(1)
+
+				ts.mockSvc.EXPECT().
+					StopHeuristicSession(id).
+					Return(fmt.Errorf("test")).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				w := httptest.NewRecorder()
+				r := httptest.NewRequest(http.MethodDelete, "/v0/heuristic/"+id.String(), nil)
+
+				ts.testHandler.ServeHTTP(w, r)
+				res := w.Result()
+
+				data, err := io.ReadAll(res.Body)
+				assert.NoError(t, err)
+
+				actualResp := &models.SessionResponse{}
+				err = json.Unmarshal(data, actualResp)
+
+				assert.NoError(t, err)
+				assert.Equal(t, models.NewSessionNoProcessResp(), actualResp)
+			},
+		},
+		{
+			name:        "Stop Heuristic Success",
+			description: "When a heuristic session is successfully stopped, its UUID should be rendered",
+			function:    "StopHeuristic",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetSession(id).
+					Return(&models.SessionSummary{}, nil).
+					Times(1)
+
+				ts.mockSvc.EXPECT().
+					StopHeuristicSession(id).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				w := httptest.NewRecorder()
+				r := httptest.NewRequest(http.MethodDelete, "/v0/heuristic/"+id.String(), nil)
+
+				ts.testHandler.ServeHTTP(w, r)
+				res := w.Result()
+
+				data, err := io.ReadAll(res.Body)
+				assert.NoError(t, err)
+
+				actualResp := &models.SessionResponse{}
+				err = json.Unmarshal(data, actualResp)
+
+				assert.NoError(t, err)
+				assert.Equal(t, models.NewSessionStoppedResp(id), actualResp)
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s-%s", i, tc.name, tc.function), func(t *testing.T) {
+			testMeta := tc.constructionLogic()
+			tc.testLogic(t, testMeta)
+		})
+	}
+}
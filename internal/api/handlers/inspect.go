@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/base-org/pessimism/internal/api/models"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/go-chi/render"
+	"go.uber.org/zap"
+)
+
+func renderInspectResponse(w http.ResponseWriter, r *http.Request,
+	ir *models.InspectResponse) {
+	w.WriteHeader(ir.Code)
+	render.JSON(w, r, ir)
+}
+
+// GetHeuristics ... Handle heuristic session listing request
+func (ph *PessimismHandler) GetHeuristics(w http.ResponseWriter, r *http.Request) {
+	sessions, err := ph.service.GetSessions()
+	if err != nil {
+		logging.WithContext(ph.ctx).
+			Error("Could not fetch heuristic sessions", zap.Error(err))
+
+		renderInspectResponse(w, r, models.NewInspectNotFoundResp())
+		return
+	}
+
+	renderInspectResponse(w, r, models.NewInspectResp(sessions))
+}
+
+// GetHeuristic ... Handle heuristic session inspection request
+func (ph *PessimismHandler) GetHeuristic(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		renderInspectResponse(w, r, models.NewInspectInvalidIDResp())
+		return
+	}
+
+	session, err := ph.service.GetSession(id)
+	if err != nil {
+		logging.WithContext(ph.ctx).
+			Debug("Could not fetch heuristic session", zap.Error(err))
+
+		renderInspectResponse(w, r, models.NewInspectNotFoundResp())
+		return
+	}
+
+	renderInspectResponse(w, r, models.NewInspectResp(session))
+}
+
+// GetPaths ... Handle path listing request
+func (ph *PessimismHandler) GetPaths(w http.ResponseWriter, r *http.Request) {
+	renderInspectResponse(w, r, models.NewInspectResp(ph.service.GetPaths()))
+}
+
+// GetPath ... Handle path inspection request
+func (ph *PessimismHandler) GetPath(w http.ResponseWriter, r *http.Request) {
+	id, err := parseID(r)
+	if err != nil {
+		renderInspectResponse(w, r, models.NewInspectInvalidIDResp())
+		return
+	}
+
+	path, err := ph.service.GetPath(id)
+	if err != nil {
+		logging.WithContext(ph.ctx).
+			Debug("Could not fetch path", zap.Error(err))
+
+		renderInspectResponse(w, r, models.NewInspectNotFoundResp())
+		return
+	}
+
+	renderInspectResponse(w, r, models.NewInspectResp(path))
+}
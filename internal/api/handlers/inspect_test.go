@@ -0,0 +1,161 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/api/models"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// serveInspect ... Routes an inspection request and decodes the response
+func serveInspect(t *testing.T, ts testSuite, route string) *models.InspectResponse {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, route, nil)
+
+	ts.testHandler.ServeHTTP(w, r)
+	res := w.Result()
+
+	data, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	actualResp := &models.InspectResponse{}
+	err = json.Unmarshal(data, actualResp)
+	assert.NoError(t, err)
+
+	assert.Equal(t, actualResp.Code, res.StatusCode)
+	return actualResp
+}
+
+func TestInspectRequest(t *testing.T) {
+	id := core.NewUUID()
+
+	var tests = []struct {
+		name        string
+		description string
+		function    string
+
+		constructionLogic func() testSuite
+		testLogic         func(*testing.T, testSuite)
+	}{
+		{
+			name:        "List Heuristics Success",
+			description: "When sessions are deployed, their summaries should be rendered",
+			function:    "GetHeuristics",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetSessions().
+					Return([]*models.SessionSummary{{ID: id.String()}}, nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				resp := serveInspect(t, ts, "/v0/heuristics")
+
+				assert.Equal(t, models.OK, resp.Status)
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, fmt.Sprintf("%v", resp.Result), id.String())
+			},
+		},
+		{
+			name:        "Get Heuristic Invalid ID",
+			description: "When provided a malformed session ID, an invalid ID response should be rendered",
+			function:    "GetHeuristic",
+
+			constructionLogic: func() testSuite {
+				return createTestSuite(t)
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				resp := serveInspect(t, ts, "/v0/heuristic/0x42")
+				assert.Equal(t, models.NewInspectInvalidIDResp(), resp)
+			},
+		},
+		{
+			name:        "Get Heuristic Not Found",
+			description: "When provided an unknown session ID, a not found response should be rendered",
+			function:    "GetHeuristic",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetSession(id).
+					Return(nil, fmt.Errorf("test")).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				resp := serveInspect(t, ts, "/v0/heuristic/"+id.String())
+				assert.Equal(t, models.NewInspectNotFoundResp(), resp)
+			},
+		},
+		{
+			name:        "List Paths Success",
+			description: "When paths exist, their summaries should be rendered",
+			function:    "GetPaths",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetPaths().
+					Return([]*models.PathSummary{{ID: id.String(), Sessions: []string{}}}).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				resp := serveInspect(t, ts, "/v0/paths")
+
+				assert.Equal(t, models.OK, resp.Status)
+				assert.Contains(t, fmt.Sprintf("%v", resp.Result), id.String())
+			},
+		},
+		{
+			name:        "Get Path Success",
+			description: "When provided a known path ID, the path summary should be rendered",
+			function:    "GetPath",
+
+			constructionLogic: func() testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockSvc.EXPECT().
+					GetPath(id).
+					Return(&models.PathSummary{ID: id.String(), State: "active"}, nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts testSuite) {
+				resp := serveInspect(t, ts, "/v0/paths/"+id.String())
+
+				assert.Equal(t, models.OK, resp.Status)
+				result, ok := resp.Result.(map[string]any)
+				assert.True(t, ok)
+				assert.Equal(t, "active", result["state"])
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s-%s", i, tc.name, tc.function), func(t *testing.T) {
+			testMeta := tc.constructionLogic()
+			tc.testLogic(t, testMeta)
+		})
+	}
+}
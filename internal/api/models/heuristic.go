@@ -12,9 +12,7 @@ type HeuristicMethod int
 
 const (
 	Run HeuristicMethod = iota
-	// NOTE - Update is not implemented yet
 	Update
-	// NOTE - Stop is not implemented yet
 	Stop
 )
 
@@ -46,6 +44,8 @@ type SessionRequestParams struct {
 
 	StartHeight *big.Int `json:"start_height"`
 	EndHeight   *big.Int `json:"end_height"`
+	// ConfirmationDepth ... Optional override of the network's default block confirmation depth
+	ConfirmationDepth *uint64 `json:"confirmation_depth,omitempty"`
 
 	SessionParams  map[string]interface{} `json:"heuristic_params"`
 	AlertingParams *core.AlertPolicy      `json:"alerting_params"`
@@ -84,15 +84,21 @@ func (hrp *SessionRequestParams) AlertPolicy() *core.AlertPolicy {
 // NewPathCfg ... Generates a path config using the request params
 func (hrp *SessionRequestParams) NewPathCfg(pollInterval time.Duration,
 	regType core.TopicType) *core.PathConfig {
+	var depth uint64
+	if hrp.ConfirmationDepth != nil {
+		depth = *hrp.ConfirmationDepth
+	}
+
 	return &core.PathConfig{
 		Network:  hrp.NetworkType(),
 		DataType: regType,
 		PathType: core.Live,
 		ClientConfig: &core.ClientConfig{
-			Network:      hrp.NetworkType(),
-			PollInterval: pollInterval,
-			StartHeight:  hrp.StartHeight,
-			EndHeight:    hrp.EndHeight,
+			Network:           hrp.NetworkType(),
+			PollInterval:      pollInterval,
+			StartHeight:       hrp.StartHeight,
+			EndHeight:         hrp.EndHeight,
+			ConfirmationDepth: depth,
 		},
 	}
 }
@@ -109,13 +115,16 @@ func (hrp *SessionRequestParams) SessionConfig() *core.SessionConfig {
 
 // SessionRequestBody ... Request body for heuristic operation request
 type SessionRequestBody struct {
-	Method string               `json:"method"`
+	Method string `json:"method"`
+	// ID ... Heuristic session UUID targeted by non-run methods
+	ID     *core.UUID           `json:"id,omitempty"`
 	Params SessionRequestParams `json:"params"`
 }
 
 func (irb *SessionRequestBody) Clone() *SessionRequestBody {
 	return &SessionRequestBody{
 		Method: irb.Method,
+		ID:     irb.ID,
 		Params: irb.Params,
 	}
 }
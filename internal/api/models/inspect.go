@@ -0,0 +1,71 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	"github.com/base-org/pessimism/internal/core"
+)
+
+// SessionSummary ... Inspection view of a deployed heuristic session
+type SessionSummary struct {
+	ID            string            `json:"id"`
+	HeuristicType string            `json:"type"`
+	Network       string            `json:"network"`
+	PathID        string            `json:"path_id"`
+	Params        json.RawMessage   `json:"heuristic_params"`
+	AlertPolicy   *core.AlertPolicy `json:"alerting_params"`
+}
+
+// ProcessSummary ... Inspection view of an ETL path process
+type ProcessSummary struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// PathSummary ... Inspection view of an ETL path
+type PathSummary struct {
+	ID          string           `json:"id"`
+	Network     string           `json:"network"`
+	State       string           `json:"state"`
+	BlockHeight *big.Int         `json:"block_height"`
+	Processes   []ProcessSummary `json:"processes"`
+	Sessions    []string         `json:"sessions"`
+}
+
+// InspectResponse ... Response for session and path inspection requests
+type InspectResponse struct {
+	Code   int                   `json:"status_code"`
+	Status SessionResponseStatus `json:"status"`
+
+	Result any    `json:"result"`
+	Error  string `json:"error"`
+}
+
+// NewInspectResp ... Returns an inspection response with status ok
+func NewInspectResp(result any) *InspectResponse {
+	return &InspectResponse{
+		Status: OK,
+		Code:   http.StatusOK,
+		Result: result,
+	}
+}
+
+// NewInspectNotFoundResp ... New inspection response for an unknown session or path
+func NewInspectNotFoundResp() *InspectResponse {
+	return &InspectResponse{
+		Status: NotOK,
+		Code:   http.StatusNotFound,
+		Error:  "could not find requested resource",
+	}
+}
+
+// NewInspectInvalidIDResp ... New inspection response for a malformed ID
+func NewInspectInvalidIDResp() *InspectResponse {
+	return &InspectResponse{
+		Status: NotOK,
+		Code:   http.StatusBadRequest,
+		Error:  "could not parse requested ID",
+	}
+}
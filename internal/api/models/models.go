@@ -16,6 +16,33 @@ func NewSessionAcceptedResp(id core.UUID) *SessionResponse {
 	}
 }
 
+// NewSessionStoppedResp ... Returns a heuristic response with status ok for a stopped session
+func NewSessionStoppedResp(id core.UUID) *SessionResponse {
+	return &SessionResponse{
+		Status: OK,
+		Code:   http.StatusOK,
+		Result: Result{logging.UUID: id.String()},
+	}
+}
+
+// NewSessionInvalidIDResp ... New invalid session ID response construction
+func NewSessionInvalidIDResp() *SessionResponse {
+	return &SessionResponse{
+		Status: NotOK,
+		Code:   http.StatusBadRequest,
+		Error:  "could not parse heuristic session ID",
+	}
+}
+
+// NewSessionNotFoundResp ... New unknown session response construction
+func NewSessionNotFoundResp() *SessionResponse {
+	return &SessionResponse{
+		Status: NotOK,
+		Code:   http.StatusNotFound,
+		Error:  "heuristic session not found",
+	}
+}
+
 // NewSessionUnmarshalErrResp ... New unmarshal error response construction
 func NewSessionUnmarshalErrResp() *SessionResponse {
 	return &SessionResponse{
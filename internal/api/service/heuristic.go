@@ -1,20 +1,93 @@
 package service
 
 import (
+	"fmt"
+
 	"github.com/base-org/pessimism/internal/api/models"
 	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	missingIDErr     = "heuristic session ID must be provided for %s method"
+	recordSessionErr = "could not record heuristic session %s: %w"
 )
 
 // ProcessHeuristicRequest ... Processes a heuristic request type
 func (svc *PessimismService) ProcessHeuristicRequest(ir *models.SessionRequestBody) (core.UUID, error) {
-	if ir.MethodType() == models.Run { // Deploy heuristic session
+	switch ir.MethodType() {
+	case models.Run: // Deploy heuristic session
 		return svc.RunHeuristicSession(&ir.Params)
+
+	case models.Stop: // Stop heuristic session
+		if ir.ID == nil {
+			return core.UUID{}, fmt.Errorf(missingIDErr, ir.Method)
+		}
+
+		if err := svc.StopHeuristicSession(*ir.ID); err != nil {
+			return core.UUID{}, err
+		}
+
+		return *ir.ID, nil
+
+	case models.Update: // Update heuristic session
+		if ir.ID == nil {
+			return core.UUID{}, fmt.Errorf(missingIDErr, ir.Method)
+		}
+
+		if err := svc.UpdateHeuristicSession(*ir.ID, &ir.Params); err != nil {
+			return core.UUID{}, err
+		}
+
+		return *ir.ID, nil
 	}
-	// TODO - Add support for other method types (ie. delete. update)
 
 	return core.UUID{}, nil
 }
 
+// UpdateHeuristicSession ... Updates the params and alert policy of a running heuristic session
+func (svc *PessimismService) UpdateHeuristicSession(id core.UUID, params *models.SessionRequestParams) error {
+	sConfig := params.SessionConfig()
+
+	// Omitted heuristic params are left unchanged
+	if len(params.SessionParams) == 0 {
+		sConfig.Params = nil
+	}
+
+	if err := svc.m.UpdateHeuristic(id, sConfig); err != nil {
+		return err
+	}
+
+	// The running session keeps the update, but the caller is told that it won't survive a restart
+	if err := svc.m.UpdateSessionRecord(id, params); err != nil {
+		return fmt.Errorf(recordSessionErr, id.ShortString(), err)
+	}
+
+	return nil
+}
+
+// recordSession ... Persists session request params so the session can be restored on restart.
+// Sessions that can't be recorded are stopped rather than left running until the next restart
+func (svc *PessimismService) recordSession(id core.UUID, params *models.SessionRequestParams) error {
+	err := svc.m.RecordSession(id, params)
+	if err == nil {
+		return nil
+	}
+
+	if stopErr := svc.m.StopHeuristic(id); stopErr != nil {
+		logging.WithContext(svc.ctx).Error("Could not stop unrecorded heuristic session",
+			zap.String(logging.UUID, id.ShortString()), zap.Error(stopErr))
+	}
+
+	return fmt.Errorf(recordSessionErr, id.ShortString(), err)
+}
+
+// StopHeuristicSession ... Stops a running heuristic session
+func (svc *PessimismService) StopHeuristicSession(id core.UUID) error {
+	return svc.m.StopHeuristic(id)
+}
+
 // RunHeuristicSession ... Runs a heuristic session provided
 func (svc *PessimismService) RunHeuristicSession(params *models.SessionRequestParams) (core.UUID, error) {
 	pConfig, err := svc.m.BuildPathCfg(params)
@@ -34,5 +107,9 @@ func (svc *PessimismService) RunHeuristicSession(params *models.SessionRequestPa
 		return core.UUID{}, err
 	}
 
+	if err := svc.recordSession(id, params); err != nil {
+		return core.UUID{}, err
+	}
+
 	return id, nil
 }
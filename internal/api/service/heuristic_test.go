@@ -7,6 +7,7 @@ import (
 	"github.com/base-org/pessimism/internal/api/models"
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -59,6 +60,11 @@ func Test_RunHeuristicSession(t *testing.T) {
 					Return(id, nil).
 					Times(1)
 
+				ts.mockSub.EXPECT().
+					RecordSession(id, &defaultBody.Params).
+					Return(nil).
+					Times(1)
+
 				return ts
 			},
 
@@ -70,6 +76,46 @@ func Test_RunHeuristicSession(t *testing.T) {
 				assert.Equal(t, id, id)
 			},
 		},
+		{
+			name: "Failure when recording heuristic session",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					BuildPathCfg(&defaultBody.Params).
+					Return(nil, nil).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					BuildDeployCfg(gomock.Any(), gomock.Any()).
+					Return(testCfg, nil).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					RunHeuristic(testCfg).
+					Return(id, nil).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					RecordSession(id, gomock.Any()).
+					Return(testErr()).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					StopHeuristic(id).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(defaultBody.Clone())
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
 		{
 			name: "Failure when building path config",
 			constructionLogic: func() *testSuite {
@@ -158,3 +204,244 @@ func Test_RunHeuristicSession(t *testing.T) {
 	}
 
 }
+
+func Test_StopHeuristicSession(t *testing.T) {
+	id := core.NewUUID()
+
+	ctrl := gomock.NewController(t)
+
+	var tests = []struct {
+		name string
+
+		constructionLogic func() *testSuite
+		testLogic         func(*testing.T, *testSuite)
+	}{
+		{
+			name: "Successful heuristic session stop",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					StopHeuristic(id).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "stop",
+					ID:     &id,
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, id, actualID)
+			},
+		},
+		{
+			name: "Failure when no session ID is provided",
+			constructionLogic: func() *testSuite {
+				return createTestSuite(ctrl)
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "stop",
+				})
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
+		{
+			name: "Failure when stopping heuristic session",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					StopHeuristic(id).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "stop",
+					ID:     &id,
+				})
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			testMeta := tc.constructionLogic()
+			tc.testLogic(t, testMeta)
+		})
+
+	}
+}
+
+func Test_UpdateHeuristicSession(t *testing.T) {
+	id := core.NewUUID()
+
+	ctrl := gomock.NewController(t)
+
+	var tests = []struct {
+		name string
+
+		constructionLogic func() *testSuite
+		testLogic         func(*testing.T, *testSuite)
+	}{
+		{
+			name: "Successful heuristic session update",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					UpdateHeuristic(id, gomock.Any()).
+					DoAndReturn(func(_ core.UUID, sConfig *core.SessionConfig) error {
+						assert.NotNil(t, sConfig.Params)
+						assert.Equal(t, common.HexToAddress("0x420"), sConfig.Params.Address())
+						return nil
+					}).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					UpdateSessionRecord(id, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "update",
+					ID:     &id,
+					Params: models.SessionRequestParams{
+						SessionParams: map[string]interface{}{"address": "0x420"},
+					},
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, id, actualID)
+			},
+		},
+		{
+			name: "Successful alert policy only update",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					UpdateHeuristic(id, gomock.Any()).
+					DoAndReturn(func(_ core.UUID, sConfig *core.SessionConfig) error {
+						assert.Nil(t, sConfig.Params)
+						assert.NotNil(t, sConfig.AlertPolicy)
+						return nil
+					}).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					UpdateSessionRecord(id, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "update",
+					ID:     &id,
+					Params: models.SessionRequestParams{
+						AlertingParams: &core.AlertPolicy{CoolDown: 60},
+					},
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, id, actualID)
+			},
+		},
+		{
+			name: "Failure when no session ID is provided",
+			constructionLogic: func() *testSuite {
+				return createTestSuite(ctrl)
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "update",
+				})
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
+		{
+			name: "Failure when updating heuristic session",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					UpdateHeuristic(id, gomock.Any()).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "update",
+					ID:     &id,
+				})
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
+		{
+			name: "Failure when updating heuristic session record",
+			constructionLogic: func() *testSuite {
+				ts := createTestSuite(ctrl)
+
+				ts.mockSub.EXPECT().
+					UpdateHeuristic(id, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				ts.mockSub.EXPECT().
+					UpdateSessionRecord(id, gomock.Any()).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+
+			testLogic: func(t *testing.T, ts *testSuite) {
+				actualID, err := ts.apiSvc.ProcessHeuristicRequest(&models.SessionRequestBody{
+					Method: "update",
+					ID:     &id,
+				})
+
+				assert.Error(t, err)
+				assert.Equal(t, core.UUID{}, actualID)
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
+			testMeta := tc.constructionLogic()
+			tc.testLogic(t, testMeta)
+		})
+
+	}
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"github.com/base-org/pessimism/internal/api/models"
+	"github.com/base-org/pessimism/internal/core"
+)
+
+// GetSession ... Returns an inspection summary of a heuristic session
+func (svc *PessimismService) GetSession(id core.UUID) (*models.SessionSummary, error) {
+	return svc.m.GetSession(id)
+}
+
+// GetSessions ... Returns inspection summaries of all heuristic sessions
+func (svc *PessimismService) GetSessions() ([]*models.SessionSummary, error) {
+	return svc.m.GetSessions()
+}
+
+// GetPath ... Returns an inspection summary of an ETL path
+func (svc *PessimismService) GetPath(id core.UUID) (*models.PathSummary, error) {
+	return svc.m.GetPath(id)
+}
+
+// GetPaths ... Returns inspection summaries of all ETL paths
+func (svc *PessimismService) GetPaths() []*models.PathSummary {
+	return svc.m.GetPaths()
+}
@@ -14,6 +14,13 @@ import (
 type Service interface {
 	ProcessHeuristicRequest(ir *models.SessionRequestBody) (core.UUID, error)
 	RunHeuristicSession(params *models.SessionRequestParams) (core.UUID, error)
+	StopHeuristicSession(id core.UUID) error
+	UpdateHeuristicSession(id core.UUID, params *models.SessionRequestParams) error
+
+	GetSession(id core.UUID) (*models.SessionSummary, error)
+	GetSessions() ([]*models.SessionSummary, error)
+	GetPath(id core.UUID) (*models.PathSummary, error)
+	GetPaths() []*models.PathSummary
 
 	CheckHealth() *models.HealthCheck
 	CheckETHRPCHealth(n core.Network) bool
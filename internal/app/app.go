@@ -73,6 +73,11 @@ func (a *Application) End() <-chan os.Signal {
 	return sigs
 }
 
+// RestoreSessions ... Redeploys heuristic sessions persisted by a prior run
+func (a *Application) RestoreSessions() ([]core.SessionID, error) {
+	return a.Subsystems.RestoreSessions()
+}
+
 // BootStrap ... Bootstraps the application
 func (a *Application) BootStrap(sessions []*BootSession) ([]core.SessionID, error) {
 	logger := logging.WithContext(a.ctx)
@@ -16,6 +16,7 @@ import (
 	"github.com/base-org/pessimism/internal/etl/registry"
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/session"
 	"github.com/base-org/pessimism/internal/state"
 	"github.com/base-org/pessimism/internal/subsystem"
 
@@ -97,6 +98,15 @@ func InitializeEngine(ctx context.Context, cfg *config.Config, transit chan core
 	return engine.NewManager(ctx, cfg.EngineConfig, re, am, store, it, transit)
 }
 
+// InitializeSessionStore ... Performs dependency injection to build the durable session store
+func InitializeSessionStore(cfg *config.Config) (session.Store, error) {
+	if cfg.SessionStorePath == "" {
+		return session.NewMemStore(), nil
+	}
+
+	return session.NewLevelDBStore(cfg.SessionStorePath)
+}
+
 // NewPessimismApp ... Performs dependency injection to build app struct
 func NewPessimismApp(ctx context.Context, cfg *config.Config) (*Application, func(), error) {
 	stats, shutDown, err := InitializeMetrics(ctx, cfg)
@@ -112,7 +122,12 @@ func NewPessimismApp(ctx context.Context, cfg *config.Config) (*Application, fun
 	engine := InitializeEngine(ctx, cfg, alerting.Transit())
 	etl := InitializeETL(ctx, engine.Transit())
 
-	m := subsystem.NewManager(ctx, cfg.SystemConfig, etl, engine, alerting)
+	sessions, err := InitializeSessionStore(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := subsystem.NewManager(ctx, cfg.SystemConfig, etl, engine, alerting, sessions)
 
 	svr, shutDown, err := InitializeServer(ctx, cfg, m)
 	if err != nil {
@@ -15,6 +15,12 @@ import (
 type Config struct {
 	L1RpcEndpoint string
 	L2RpcEndpoint string
+	// L1WsEndpoint & L2WsEndpoint ... Optional WebSocket RPC endpoints used to
+	// subscribe to new chain heads rather than polling for them
+	L1WsEndpoint string
+	L2WsEndpoint string
+	// OpNodeEndpoint ... Optional op-node rollup RPC endpoint used to query L2 sync status
+	OpNodeEndpoint string
 
 	IndexerCfg *ix_client.Config
 }
@@ -24,9 +30,17 @@ type Bundle struct {
 	IxClient IxClient
 	L1Client EthClient
 	L1Node   ix_node.EthClient
+	L1Geth   GethClient
 	L2Client EthClient
 	L2Node   ix_node.EthClient
 	L2Geth   GethClient
+
+	// OpNode ... Nil when no op-node endpoint is configured
+	OpNode OpNodeClient
+
+	// L1Subscriber & L2Subscriber ... Nil when no WebSocket endpoint is configured
+	L1Subscriber HeadSubscriber
+	L2Subscriber HeadSubscriber
 }
 
 // NewBundle ... Construct a new client bundle
@@ -45,6 +59,12 @@ func NewBundle(ctx context.Context, cfg *Config) (*Bundle, error) {
 		return nil, err
 	}
 
+	l1Geth, err := NewGethClient(cfg.L1RpcEndpoint)
+	if err != nil {
+		logger.Fatal("Error creating L1 GETH client", zap.Error(err))
+		return nil, err
+	}
+
 	l2Client, err := NewEthClient(ctx, cfg.L2RpcEndpoint)
 	if err != nil {
 		logger.Fatal("Error creating L1 client", zap.Error(err))
@@ -63,6 +83,24 @@ func NewBundle(ctx context.Context, cfg *Config) (*Bundle, error) {
 		return nil, err
 	}
 
+	l1Subscriber, err := newOptionalHeadSubscriber(ctx, cfg.L1WsEndpoint)
+	if err != nil {
+		logger.Fatal("Error creating L1 head subscriber", zap.Error(err))
+		return nil, err
+	}
+
+	l2Subscriber, err := newOptionalHeadSubscriber(ctx, cfg.L2WsEndpoint)
+	if err != nil {
+		logger.Fatal("Error creating L2 head subscriber", zap.Error(err))
+		return nil, err
+	}
+
+	opNode, err := newOptionalOpNodeClient(ctx, cfg.OpNodeEndpoint)
+	if err != nil {
+		logger.Fatal("Error creating op-node client", zap.Error(err))
+		return nil, err
+	}
+
 	ixClient, err := NewIndexerClient(cfg.IndexerCfg)
 	if err != nil { // Indexer client is optional so we don't want to fatal
 		logger.Warn("Error creating indexer client", zap.Error(err))
@@ -71,13 +109,36 @@ func NewBundle(ctx context.Context, cfg *Config) (*Bundle, error) {
 	return &Bundle{
 		L1Client: l1Client,
 		L1Node:   l1NodeClient,
+		L1Geth:   l1Geth,
 		L2Client: l2Client,
 		L2Node:   l2NodeClient,
 		IxClient: ixClient,
 		L2Geth:   l2Geth,
+		OpNode:   opNode,
+
+		L1Subscriber: l1Subscriber,
+		L2Subscriber: l2Subscriber,
 	}, nil
 }
 
+// newOptionalHeadSubscriber ... Returns a head subscriber if a WebSocket endpoint is provided
+func newOptionalHeadSubscriber(ctx context.Context, wsURL string) (HeadSubscriber, error) {
+	if wsURL == "" {
+		return nil, nil
+	}
+
+	return NewHeadSubscriber(ctx, wsURL)
+}
+
+// newOptionalOpNodeClient ... Returns an op-node client if an endpoint is provided
+func newOptionalOpNodeClient(ctx context.Context, rawURL string) (OpNodeClient, error) {
+	if rawURL == "" {
+		return nil, nil
+	}
+
+	return NewOpNodeClient(ctx, rawURL)
+}
+
 // FromContext ... Retrieves the client bundle from the context
 func FromContext(ctx context.Context) (*Bundle, error) {
 	b, err := ctx.Value(core.Clients).(*Bundle)
@@ -102,6 +163,20 @@ func (b *Bundle) NodeClient(n core.Network) (ix_node.EthClient, error) {
 	}
 }
 
+// HeadSubscriber ... Returns the head subscriber for a network if one is configured
+func (b *Bundle) HeadSubscriber(n core.Network) (HeadSubscriber, bool) {
+	var sub HeadSubscriber
+	switch n {
+	case core.Layer1:
+		sub = b.L1Subscriber
+
+	case core.Layer2:
+		sub = b.L2Subscriber
+	}
+
+	return sub, sub != nil
+}
+
 // FromNetwork ... Retrieves an eth client from the context
 func FromNetwork(ctx context.Context, n core.Network) (EthClient, error) {
 	bundle, err := FromContext(ctx)
@@ -118,3 +193,34 @@ func FromNetwork(ctx context.Context, n core.Network) (EthClient, error) {
 		return nil, fmt.Errorf("invalid network supplied")
 	}
 }
+
+// GethFromNetwork ... Retrieves a geth client from the context
+func GethFromNetwork(ctx context.Context, n core.Network) (GethClient, error) {
+	bundle, err := FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n {
+	case core.Layer1:
+		return bundle.L1Geth, nil
+	case core.Layer2:
+		return bundle.L2Geth, nil
+	default:
+		return nil, fmt.Errorf("invalid network supplied")
+	}
+}
+
+// OpNodeFromContext ... Retrieves the op-node client from the context
+func OpNodeFromContext(ctx context.Context) (OpNodeClient, error) {
+	bundle, err := FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if bundle.OpNode == nil {
+		return nil, fmt.Errorf("no op-node client configured")
+	}
+
+	return bundle.OpNode, nil
+}
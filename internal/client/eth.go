@@ -1,4 +1,4 @@
-//go:generate mockgen -package mocks --destination ../mocks/eth_client.go . EthClient,NodeClient
+//go:generate mockgen -package mocks --destination ../mocks/eth_client.go . EthClient,NodeClient,HeadSubscriber
 
 package client
 
@@ -20,8 +20,11 @@ type EthClient interface {
 
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 
 	BalanceAt(ctx context.Context, account common.Address, number *big.Int) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
 	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
 	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery,
 		ch chan<- types.Log) (ethereum.Subscription, error)
@@ -38,11 +41,22 @@ type NodeClient interface {
 	FilterLogs(ethereum.FilterQuery) ([]types.Log, error)
 }
 
+// HeadSubscriber ... Push based chain head notifier backed by a WebSocket RPC endpoint
+type HeadSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
 // NewEthClient ... Initializer
 func NewEthClient(ctx context.Context, rawURL string) (EthClient, error) {
 	return ethclient.DialContext(ctx, rawURL)
 }
 
+// NewHeadSubscriber ... Initializer. Subscriptions that are dropped by the server
+// can be re-established using the same subscriber, which redials the endpoint as needed
+func NewHeadSubscriber(ctx context.Context, wsURL string) (HeadSubscriber, error) {
+	return ethclient.DialContext(ctx, wsURL)
+}
+
 func NewNodeClient(ctx context.Context, rpcURL string) (NodeClient, error) {
 	stats := metrics.WithContext(ctx)
 
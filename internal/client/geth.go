@@ -6,15 +6,29 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/base-org/pessimism/internal/core"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+const (
+	traceBlockByHash = "debug_traceBlockByHash"
+	callTracer       = "callTracer"
+)
+
 // GethClient ... Provides interface wrapper for gethClient functions
 type GethClient interface {
 	GetProof(ctx context.Context, account common.Address, keys []string,
 		blockNumber *big.Int) (*gethclient.AccountResult, error)
+	TraceBlockByHash(ctx context.Context, hash common.Hash) ([]core.TxTrace, error)
+}
+
+// gethClient ... Extends the gethClient with debug namespace methods
+type gethClient struct {
+	*gethclient.Client
+
+	rpc *rpc.Client
 }
 
 // NewGethClient ... Initializer
@@ -24,6 +38,20 @@ func NewGethClient(rawURL string) (GethClient, error) {
 		return nil, err
 	}
 
-	gethClient := gethclient.New(rpcClient)
-	return gethClient, nil
+	return &gethClient{
+		Client: gethclient.New(rpcClient),
+		rpc:    rpcClient,
+	}, nil
+}
+
+// TraceBlockByHash ... Returns the call traces of every transaction in a block
+func (gc *gethClient) TraceBlockByHash(ctx context.Context, hash common.Hash) ([]core.TxTrace, error) {
+	var traces []core.TxTrace
+	cfg := map[string]interface{}{"tracer": callTracer}
+
+	if err := gc.rpc.CallContext(ctx, &traces, traceBlockByHash, hash, cfg); err != nil {
+		return nil, err
+	}
+
+	return traces, nil
 }
@@ -0,0 +1,47 @@
+//go:generate mockgen -package mocks --destination ../mocks/op_node_client.go . OpNodeClient
+
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	syncStatus = "optimism_syncStatus"
+)
+
+// OpNodeClient ... Provides interface wrapper for op-node rollup RPC methods
+type OpNodeClient interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+// opNodeClient ... Rollup RPC client for an op-node
+type opNodeClient struct {
+	rpc *rpc.Client
+}
+
+// NewOpNodeClient ... Initializer
+func NewOpNodeClient(ctx context.Context, rawURL string) (OpNodeClient, error) {
+	rpcClient, err := rpc.DialContext(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opNodeClient{
+		rpc: rpcClient,
+	}, nil
+}
+
+// SyncStatus ... Returns the current sync status of the op-node (i.e. unsafe, safe & finalized L2 heads)
+func (oc *opNodeClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	var status *eth.SyncStatus
+
+	if err := oc.rpc.CallContext(ctx, &status, syncStatus); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,53 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestOpNodeSyncStatus(t *testing.T) {
+	status := &eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: 100},
+		SafeL2:      eth.L2BlockRef{Number: 90},
+		FinalizedL2: eth.L2BlockRef{Number: 50},
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if req.Method == "optimism_syncStatus" {
+			resp["result"] = status
+		} else {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer svr.Close()
+
+	oc, err := client.NewOpNodeClient(context.Background(), svr.URL)
+	assert.NoError(t, err)
+
+	actual, err := oc.SyncStatus(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), actual.UnsafeL2.Number)
+	assert.Equal(t, uint64(90), actual.SafeL2.Number)
+	assert.Equal(t, uint64(50), actual.FinalizedL2.Number)
+}
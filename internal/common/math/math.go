@@ -10,6 +10,12 @@ func WeiToEther(wei *big.Int) *big.Float {
 	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether))
 }
 
+// ToDecimal ... Converts an integer token amount to a decimal amount in token units
+func ToDecimal(amount *big.Int, decimals uint8) *big.Float {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	return new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetInt(unit))
+}
+
 // PercentOf - calculate what percent x0 is of x1.
 func PercentOf(part, total *big.Float) *big.Float {
 	whole := 100.0
@@ -45,3 +45,13 @@ func Test_PercentOf(t *testing.T) {
 	assert(t, big.NewFloat(25), big.NewFloat(100), big.NewFloat(25))
 	assert(t, big.NewFloat(10), big.NewFloat(100), big.NewFloat(10))
 }
+
+func Test_ToDecimal(t *testing.T) {
+	amount := big.NewInt(1_500_000)
+
+	usdc, _ := math.ToDecimal(amount, 6).Float64()
+	assert.Equal(t, 1.5, usdc)
+
+	raw, _ := math.ToDecimal(amount, 0).Float64()
+	assert.Equal(t, float64(1_500_000), raw)
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/base-org/pessimism/internal/alert"
 	"github.com/base-org/pessimism/internal/api/server"
@@ -14,6 +15,7 @@ import (
 	"github.com/base-org/pessimism/internal/engine"
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/state"
 	"github.com/base-org/pessimism/internal/subsystem"
 	"gopkg.in/yaml.v3"
 
@@ -27,19 +29,22 @@ import (
 const (
 	trueEnvVal               = "1"
 	defaultEngineWorkerCount = 6
+	defaultTickInterval      = 1000 // milliseconds
 )
 
 // Config ... Application level configuration defined by `FilePath` value
 // TODO - Consider renaming to "environment config"
 type Config struct {
-	Environment   core.Env
-	BootStrapPath string
+	Environment      core.Env
+	BootStrapPath    string
+	SessionStorePath string
 
 	AlertConfig   *alert.Config
 	ClientConfig  *client.Config
 	EngineConfig  *engine.Config
 	MetricsConfig *metrics.Config
 	ServerConfig  *server.Config
+	StateConfig   *state.Config
 	SystemConfig  *subsystem.Config
 }
 
@@ -51,8 +56,9 @@ func NewConfig(fileName core.FilePath) *Config {
 
 	config := &Config{
 
-		BootStrapPath: getEnvStrWithDefault("BOOTSTRAP_PATH", ""),
-		Environment:   core.Env(getEnvStr("ENV")),
+		BootStrapPath:    getEnvStrWithDefault("BOOTSTRAP_PATH", ""),
+		SessionStorePath: getEnvStrWithDefault("SESSION_STORE_PATH", ""),
+		Environment:      core.Env(getEnvStr("ENV")),
 
 		AlertConfig: &alert.Config{
 			RoutingCfgPath:          getEnvStrWithDefault("ALERT_ROUTE_CFG_PATH", "alerts-routing.yaml"),
@@ -67,6 +73,10 @@ func NewConfig(fileName core.FilePath) *Config {
 		ClientConfig: &client.Config{
 			L1RpcEndpoint: getEnvStr("L1_RPC_ENDPOINT"),
 			L2RpcEndpoint: getEnvStr("L2_RPC_ENDPOINT"),
+			L1WsEndpoint:  getEnvStrWithDefault("L1_WS_ENDPOINT", ""),
+			L2WsEndpoint:  getEnvStrWithDefault("L2_WS_ENDPOINT", ""),
+
+			OpNodeEndpoint: getEnvStrWithDefault("OP_NODE_RPC_ENDPOINT", ""),
 			IndexerCfg: &indexer_client.Config{
 				BaseURL:         getEnvStrWithDefault("INDEXER_URL", ""),
 				PaginationLimit: getEnvIntWithDefault("INDEXER_PAGINATION_LIMIT", 0),
@@ -74,7 +84,8 @@ func NewConfig(fileName core.FilePath) *Config {
 		},
 
 		EngineConfig: &engine.Config{
-			WorkerCount: getEnvIntWithDefault("ENGINE_WORKER_COUNT", defaultEngineWorkerCount),
+			WorkerCount:  getEnvIntWithDefault("ENGINE_WORKER_COUNT", defaultEngineWorkerCount),
+			TickInterval: time.Duration(getEnvIntWithDefault("ENGINE_TICK_INTERVAL", defaultTickInterval)) * time.Millisecond,
 		},
 
 		MetricsConfig: &metrics.Config{
@@ -92,10 +103,22 @@ func NewConfig(fileName core.FilePath) *Config {
 			WriteTimeout: getEnvInt("SERVER_WRITE_TIMEOUT"),
 		},
 
+		StateConfig: &state.Config{
+			Type:           getEnvStrWithDefault("STATE_STORE_TYPE", state.MemoryType),
+			RedisURL:       getEnvStrWithDefault("REDIS_URL", ""),
+			RedisNamespace: getEnvStrWithDefault("REDIS_NAMESPACE", state.DefaultNamespace),
+		},
+
 		SystemConfig: &subsystem.Config{
 			MaxPathCount:   getEnvInt("MAX_PATH_COUNT"),
 			L1PollInterval: getEnvInt("L1_POLL_INTERVAL"),
 			L2PollInterval: getEnvInt("L2_POLL_INTERVAL"),
+			PollFallback:   getEnvStrWithDefault("POLL_FALLBACK", trueEnvVal) == trueEnvVal,
+
+			L1Confirmations: getEnvUintWithDefault("L1_CONFIRMATIONS", 0),
+			L2Confirmations: getEnvUintWithDefault("L2_CONFIRMATIONS", 0),
+
+			MaxPathLag: getEnvUintWithDefault("MAX_PATH_LAG", 0),
 		},
 	}
 
@@ -163,6 +186,23 @@ func getEnvIntWithDefault(key string, defaultValue int) int {
 	return intRep
 }
 
+// getEnvUintWithDefault ... Reads non-negative env var from process environment, returns default if not found
+func getEnvUintWithDefault(key string, defaultValue uint64) uint64 {
+	envVar, ok := os.LookupEnv(key)
+
+	// Not found
+	if !ok {
+		return defaultValue
+	}
+
+	uintRep, err := strconv.ParseUint(envVar, 10, 64)
+	if err != nil {
+		log.Fatalf("env val is not a non-negative int; got: %s=%s; err: %s", key, envVar, err.Error())
+	}
+
+	return uintRep
+}
+
 // getEnvBool ... Reads env vars and converts to booleans
 func getEnvBool(key string) bool {
 	return getEnvStr(key) == trueEnvVal
@@ -2,6 +2,8 @@ package core
 
 import (
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // PagerDutySeverity ... represents the severity of an event
@@ -117,6 +119,15 @@ type Alert struct {
 	Timestamp   time.Time
 	PathType    PathType
 
+	// BlockHash ... Hash of the block that triggered the heuristic activation
+	BlockHash common.Hash
+	// Orphaned ... Indicates that the alert retracts an earlier activation
+	// which was based on a block that has since been reorged out
+	Orphaned bool
+	// Internal ... Indicates that the alert was raised by Pessimism about its own
+	// operation rather than by a heuristic session
+	Internal bool
+
 	Content string
 }
 
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Tx ... Transaction included in a block along with its recovered sender
+type Tx struct {
+	*types.Transaction
+
+	From        common.Address
+	BlockHash   common.Hash
+	BlockNumber *big.Int
+	Index       uint
+}
+
+// TxReceipt ... Receipt of a transaction along with the transaction itself
+type TxReceipt struct {
+	*types.Receipt
+
+	Tx Tx
+}
+
+// Reverted ... Returns true if the transaction failed
+func (r TxReceipt) Reverted() bool {
+	return r.Status == types.ReceiptStatusFailed
+}
+
+// CallFrame ... Call frame produced by geth's callTracer
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// TxTrace ... Call trace of a single transaction in a block
+type TxTrace struct {
+	TxHash common.Hash `json:"txHash"`
+	Result *CallFrame  `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// CallTrace ... Flattened call frame of a transaction
+type CallTrace struct {
+	TxHash      common.Hash
+	BlockHash   common.Hash
+	BlockNumber *big.Int
+
+	Type  string
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	// Selector ... First four bytes of the call input, nil when the input is shorter
+	Selector []byte
+	// Depth ... Zero for the top level call of a transaction
+	Depth int
+	Error string
+}
+
+// SlotValue ... Value of a contract storage slot at some block
+type SlotValue struct {
+	Address     common.Address
+	Slot        common.Hash
+	Value       common.Hash
+	BlockNumber *big.Int
+}
@@ -14,9 +14,13 @@ func RetryStrategy() *retry.ExponentialStrategy {
 type ClientConfig struct {
 	Network      Network
 	PollInterval time.Duration
+	// PollFallback ... Poll for new headers while a head subscription is down
+	PollFallback bool
 	NumOfRetries int
 	StartHeight  *big.Int
 	EndHeight    *big.Int
+	// ConfirmationDepth ... Number of blocks a header must be buried by before being emitted
+	ConfirmationDepth uint64
 }
 
 type SessionConfig struct {
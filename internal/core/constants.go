@@ -91,6 +91,16 @@ const (
 	ContractEvent
 	FaultDetector
 	WithdrawalSafety
+	StorageWatch
+	ContractCall
+	ERC20BalanceEnforcement
+	BalanceDelta
+	ChainLiveness
+	SafeHeadLag
+	DepositSafety
+	TransactionWatch
+	RevertRate
+	CallWatch
 )
 
 // String ... Converts a heuristic type to a string
@@ -108,6 +118,36 @@ func (it HeuristicType) String() string {
 	case WithdrawalSafety:
 		return "withdrawal_safety"
 
+	case StorageWatch:
+		return "storage_watch"
+
+	case ContractCall:
+		return "contract_call"
+
+	case ERC20BalanceEnforcement:
+		return "erc20_balance_enforcement"
+
+	case BalanceDelta:
+		return "balance_delta"
+
+	case ChainLiveness:
+		return "chain_liveness"
+
+	case SafeHeadLag:
+		return "safe_head_lag"
+
+	case DepositSafety:
+		return "deposit_safety"
+
+	case TransactionWatch:
+		return "transaction_watch"
+
+	case RevertRate:
+		return "revert_rate"
+
+	case CallWatch:
+		return "call_watch"
+
 	default:
 		return "unknown"
 	}
@@ -128,6 +168,36 @@ func StringToHeuristicType(stringType string) HeuristicType {
 	case "withdrawal_safety":
 		return WithdrawalSafety
 
+	case "storage_watch":
+		return StorageWatch
+
+	case "contract_call":
+		return ContractCall
+
+	case "erc20_balance_enforcement":
+		return ERC20BalanceEnforcement
+
+	case "balance_delta":
+		return BalanceDelta
+
+	case "chain_liveness":
+		return ChainLiveness
+
+	case "safe_head_lag":
+		return SafeHeadLag
+
+	case "deposit_safety":
+		return DepositSafety
+
+	case "transaction_watch":
+		return TransactionWatch
+
+	case "revert_rate":
+		return RevertRate
+
+	case "call_watch":
+		return CallWatch
+
 	default:
 		return HeuristicType(0)
 	}
@@ -29,6 +29,20 @@ func WithOriginTS(t time.Time) RelayOption {
 	}
 }
 
+// WithBlockHash ... Injects the hash of the block the data was derived from
+func WithBlockHash(hash common.Hash) RelayOption {
+	return func(e *Event) {
+		e.BlockHash = hash
+	}
+}
+
+// WithNetwork ... Injects the network the data was derived from
+func WithNetwork(n Network) RelayOption {
+	return func(e *Event) {
+		e.Network = n
+	}
+}
+
 type Event struct {
 	OriginTS  time.Time
 	Timestamp time.Time
@@ -37,7 +51,9 @@ type Event struct {
 	Type    TopicType
 
 	Address common.Address
-	Value   any
+	// BlockHash ... Hash of the block the event was derived from; used for reorg tracking
+	BlockHash common.Hash
+	Value     any
 }
 
 func NewEvent(rt TopicType, val any, opts ...RelayOption) Event {
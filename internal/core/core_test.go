@@ -83,3 +83,15 @@ test_key2: "test_value2"
 	assert.Equal(t, "test_value", t1.TestKey.String(), "Unmarshal should return the correct value")
 	assert.Equal(t, "test_value2", t1.TestKey2.String(), "Unmarshal should return the correct value")
 }
+
+func Test_ChainReorg(t *testing.T) {
+	orphan := common.HexToHash("0x123")
+
+	cr := core.ChainReorg{
+		Orphaned: []common.Hash{orphan},
+	}
+
+	assert.Equal(t, 1, cr.Depth())
+	assert.True(t, cr.IsOrphaned(orphan))
+	assert.False(t, cr.IsOrphaned(common.HexToHash("0x456")))
+}
@@ -1,5 +1,9 @@
 package core
 
+import (
+	"math/big"
+)
+
 // ProcessType ... Denotes the ETL process type
 type ProcessType uint8
 
@@ -27,3 +31,44 @@ type PathType uint8
 const (
 	Live PathType = iota + 1
 )
+
+// PathHealth ... Denotes the health of an ETL path as reported by the ETL supervisor
+type PathHealth uint8
+
+const (
+	// PathRestarting ... A path process crashed and is being restarted
+	PathRestarting PathHealth = iota + 1
+	// PathFailed ... A path was torn down after its processes repeatedly crashed
+	PathFailed
+)
+
+// String ... Converts the path health to a string
+func (ph PathHealth) String() string {
+	switch ph {
+	case PathRestarting:
+		return "restarting"
+
+	case PathFailed:
+		return "failed"
+	}
+
+	return UnknownType
+}
+
+// PathHealthEvent ... Path health change emitted by the ETL supervisor
+type PathHealthEvent struct {
+	ID       PathID
+	Process  ProcessID
+	Health   PathHealth
+	Restarts int
+	Err      error
+}
+
+// PathInfo ... Runtime snapshot of an ETL path used for inspection
+type PathInfo struct {
+	ID        PathID
+	State     string
+	Height    *big.Int
+	Lag       *big.Int
+	Processes []ProcessID
+}
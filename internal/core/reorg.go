@@ -0,0 +1,33 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainReorg ... Describes a chain reorganization observed by a header reader.
+// Orphaned holds the hashes of previously emitted blocks that are no longer canonical
+type ChainReorg struct {
+	Network        Network
+	AncestorHash   common.Hash
+	AncestorHeight *big.Int
+
+	Orphaned []common.Hash
+}
+
+// Depth ... Returns the number of orphaned blocks
+func (cr *ChainReorg) Depth() int {
+	return len(cr.Orphaned)
+}
+
+// IsOrphaned ... Returns true if the provided block hash was reorged out
+func (cr *ChainReorg) IsOrphaned(hash common.Hash) bool {
+	for _, orphan := range cr.Orphaned {
+		if orphan == hash {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type StateKey struct {
@@ -29,6 +31,24 @@ func MakeStateKey(pre TopicType, id string, nest bool) *StateKey {
 	}
 }
 
+// MakeCheckpointKey ... Returns the state key used to store the last fully processed
+// block height of live paths with the same identifier and confirmation depth
+func MakeCheckpointKey(id PathIdentifier, depth uint64) *StateKey {
+	return &StateKey{
+		Prefix: BlockHeader,
+		ID:     fmt.Sprintf("checkpoint-%s-%d", id.String(), depth),
+	}
+}
+
+// MakeWithdrawalKey ... Returns the state key used to store a withdrawal initiated on
+// an L2ToL1MessagePasser contract so that it can be correlated with its L1 proof
+func MakeWithdrawalKey(msgPasser common.Address, hash common.Hash) *StateKey {
+	return &StateKey{
+		Prefix: Log,
+		ID:     fmt.Sprintf("withdrawal-%s-%s", msgPasser.Hex(), hash.Hex()),
+	}
+}
+
 func (sk *StateKey) IsNested() bool {
 	return sk.Nesting
 }
@@ -5,6 +5,15 @@ type TopicType uint8
 const (
 	BlockHeader TopicType = iota + 1
 	Log
+	// Reorg ... Notification emitted when a header reader detects a chain reorganization
+	Reorg
+	Transaction
+	Receipt
+	Trace
+	StorageSlot
+	// Tick ... Periodic notification emitted by the risk engine to heuristics
+	// that must be assessed even when no input arrives
+	Tick
 )
 
 func (rt TopicType) String() string {
@@ -14,6 +23,24 @@ func (rt TopicType) String() string {
 
 	case Log:
 		return "log"
+
+	case Reorg:
+		return "reorg"
+
+	case Transaction:
+		return "transaction"
+
+	case Receipt:
+		return "receipt"
+
+	case Trace:
+		return "trace"
+
+	case StorageSlot:
+		return "storage_slot"
+
+	case Tick:
+		return "tick"
 	}
 
 	return UnknownType
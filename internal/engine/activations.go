@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxActivations ... Max number of recent alerts retained per path for reorg correlation
+	maxActivations = 256
+)
+
+// activationLog ... Bounded record of recently generated alerts per path
+// used to determine which activations were based on orphaned blocks
+type activationLog struct {
+	entries map[core.PathID][]core.Alert
+	// orphans ... Recently orphaned block hashes per path. Alerts for these blocks that are
+	// generated after their reorg was handled (e.g. queued or retried assessments) are dropped
+	orphans map[core.PathID][]common.Hash
+
+	*sync.Mutex
+}
+
+// newActivationLog ... Initializer
+func newActivationLog() *activationLog {
+	return &activationLog{
+		entries: make(map[core.PathID][]core.Alert),
+		orphans: make(map[core.PathID][]common.Hash),
+		Mutex:   &sync.Mutex{},
+	}
+}
+
+// Add ... Records an alert that was generated from a known block. False is returned
+// if the block was already orphaned, in which case the alert shouldn't be emitted
+func (al *activationLog) Add(alert core.Alert) bool {
+	if alert.BlockHash == (common.Hash{}) {
+		return true
+	}
+
+	al.Lock()
+	defer al.Unlock()
+
+	for _, orphan := range al.orphans[alert.PathID] {
+		if orphan == alert.BlockHash {
+			return false
+		}
+	}
+
+	entries := append(al.entries[alert.PathID], alert)
+	if len(entries) > maxActivations {
+		entries = entries[len(entries)-maxActivations:]
+	}
+
+	al.entries[alert.PathID] = entries
+	return true
+}
+
+// Orphaned ... Removes and returns all alerts for a path that were
+// generated from blocks orphaned by the provided reorg. The orphaned blocks
+// are remembered so that alerts generated from them afterwards are dropped
+func (al *activationLog) Orphaned(id core.PathID, reorg core.ChainReorg) []core.Alert {
+	al.Lock()
+	defer al.Unlock()
+
+	orphans := append(al.orphans[id], reorg.Orphaned...)
+	if len(orphans) > maxActivations {
+		orphans = orphans[len(orphans)-maxActivations:]
+	}
+	al.orphans[id] = orphans
+
+	orphaned := make([]core.Alert, 0)
+	remaining := make([]core.Alert, 0, len(al.entries[id]))
+
+	for _, alert := range al.entries[id] {
+		if reorg.IsOrphaned(alert.BlockHash) {
+			orphaned = append(orphaned, alert)
+			continue
+		}
+
+		remaining = append(remaining, alert)
+	}
+
+	al.entries[id] = remaining
+	return orphaned
+}
@@ -2,13 +2,17 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// AddressMap ... Address to heuristic session mapping shared by the engine workers and API handlers
 type AddressMap struct {
 	m map[common.Address]map[core.PathID][]core.UUID
+
+	mu sync.RWMutex
 }
 
 func NewAddressMap() *AddressMap {
@@ -17,19 +21,35 @@ func NewAddressMap() *AddressMap {
 	}
 }
 
+// Get ... Returns a copy of the heuristic session UUIDs for an address and path
 func (am *AddressMap) Get(address common.Address, id core.PathID) ([]core.UUID, error) {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	entries, err := am.get(address, id)
+	if err != nil {
+		return []core.UUID{}, err
+	}
+
+	return append([]core.UUID(nil), entries...), nil
+}
+
+func (am *AddressMap) get(address common.Address, id core.PathID) ([]core.UUID, error) {
 	if _, found := am.m[address]; !found {
-		return []core.UUID{}, fmt.Errorf("address provided is not tracked %s", address.String())
+		return nil, fmt.Errorf("address provided is not tracked %s", address.String())
 	}
 
 	if _, found := am.m[address][id]; !found {
-		return []core.UUID{}, fmt.Errorf("id provided is not tracked %s", id.String())
+		return nil, fmt.Errorf("id provided is not tracked %s", id.String())
 	}
 
 	return am.m[address][id], nil
 }
 
 func (am *AddressMap) Insert(addr common.Address, id core.PathID, uuid core.UUID) error {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
 	// 1. Check if address exists; create nested entry & return if not
 	if _, found := am.m[addr]; !found {
 		am.m[addr] = make(map[core.PathID][]core.UUID)
@@ -54,3 +74,39 @@ func (am *AddressMap) Insert(addr common.Address, id core.PathID, uuid core.UUID
 	am.m[addr][id] = append(am.m[addr][id], uuid)
 	return nil
 }
+
+// Remove ... Removes a heuristic session entry for an address and path,
+// pruning any nested entries that become empty
+func (am *AddressMap) Remove(addr common.Address, id core.PathID, uuid core.UUID) error {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	entries, err := am.get(addr, id)
+	if err != nil {
+		return err
+	}
+
+	// A new slice is built so that entries already handed out to workers are left intact
+	remaining := make([]core.UUID, 0, len(entries))
+	for _, entry := range entries {
+		if entry != uuid {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	if len(remaining) == len(entries) {
+		return fmt.Errorf("entry does not exist")
+	}
+
+	if len(remaining) > 0 {
+		am.m[addr][id] = remaining
+		return nil
+	}
+
+	delete(am.m[addr], id)
+	if len(am.m[addr]) == 0 {
+		delete(am.m, addr)
+	}
+
+	return nil
+}
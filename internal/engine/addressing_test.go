@@ -45,3 +45,33 @@ func TestGetUUIDs(t *testing.T) {
 	assert.Error(t, err, "should error")
 	assert.Empty(t, ids, "should be empty")
 }
+
+func TestRemoveUUIDs(t *testing.T) {
+	am := engine.NewAddressMap()
+
+	id1 := core.NewUUID()
+	id2 := core.NewUUID()
+	address := common.HexToAddress("0x24")
+
+	assert.NoError(t, am.Insert(address, pathID, id1))
+	assert.NoError(t, am.Insert(address, pathID, id2))
+
+	// Test for removal with remaining entries
+	err := am.Remove(address, pathID, id1)
+	assert.NoError(t, err)
+
+	ids, err := am.Get(address, pathID)
+	assert.NoError(t, err)
+	assert.Equal(t, []core.UUID{id2}, ids)
+
+	// Test for removal of unknown entry
+	err = am.Remove(address, pathID, id1)
+	assert.Error(t, err, "should error")
+
+	// Test for removal of final entry
+	err = am.Remove(address, pathID, id2)
+	assert.NoError(t, err)
+
+	_, err = am.Get(address, pathID)
+	assert.Error(t, err, "should error")
+}
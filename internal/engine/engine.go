@@ -36,6 +36,7 @@ type RiskEngine interface {
 		h heuristic.Heuristic) (*heuristic.ActivationSet, error)
 	AddWorkerIngress(chan ExecInput)
 	EventLoop(context.Context)
+	OrphanedActivations(id core.PathID, reorg core.ChainReorg) []core.Alert
 }
 
 // hardCodedEngine ... Hard coded execution engine
@@ -43,12 +44,15 @@ type RiskEngine interface {
 type hardCodedEngine struct {
 	heuristicIn chan ExecInput
 	alertEgress chan core.Alert
+
+	activations *activationLog
 }
 
 // NewHardCodedEngine ... Initializer
 func NewHardCodedEngine(egress chan core.Alert) RiskEngine {
 	return &hardCodedEngine{
 		alertEgress: egress,
+		activations: newActivationLog(),
 	}
 }
 
@@ -62,6 +66,12 @@ func (hce *hardCodedEngine) AddWorkerIngress(ingress chan ExecInput) {
 	hce.heuristicIn = ingress
 }
 
+// OrphanedActivations ... Returns previously generated alerts for a path
+// that were based on blocks orphaned by the reorg
+func (hce *hardCodedEngine) OrphanedActivations(id core.PathID, reorg core.ChainReorg) []core.Alert {
+	return hce.activations.Orphaned(id, reorg)
+}
+
 // Execute ... Executes the heuristic
 func (hce *hardCodedEngine) Execute(ctx context.Context, data core.Event,
 	h heuristic.Heuristic) (*heuristic.ActivationSet, error) {
@@ -69,7 +79,7 @@ func (hce *hardCodedEngine) Execute(ctx context.Context, data core.Event,
 
 	logger.Debug("Performing heuristic assessment",
 		zap.String(logging.UUID, h.ID().ShortString()))
-	as, err := h.Assess(data)
+	as, err := assess(data, h)
 	if err != nil {
 		logger.Error("Failed to perform activation option for heuristic", zap.Error(err),
 			zap.String("heuristic_type", h.TopicType().String()))
@@ -120,6 +130,15 @@ func (hce *hardCodedEngine) EventLoop(ctx context.Context) {
 						Content:     act.Message,
 						PathID:      args.hi.PathID,
 						Net:         args.hi.PathID.Network(),
+						BlockHash:   args.hi.Input.BlockHash,
+					}
+
+					// The block may have been reorged out while the assessment was queued or retried
+					if !hce.activations.Add(alert) {
+						logger.Warn("Dropping heuristic alert for orphaned block",
+							zap.String(logging.UUID, args.h.ID().ShortString()),
+							zap.String("block_hash", alert.BlockHash.String()))
+						continue
 					}
 
 					logger.Warn("Heuristic alert",
@@ -133,3 +152,18 @@ func (hce *hardCodedEngine) EventLoop(ctx context.Context) {
 		}
 	}
 }
+
+// assess ... Assesses an event using the heuristic. Tick events are
+// only delivered to heuristics that handle them
+func assess(data core.Event, h heuristic.Heuristic) (*heuristic.ActivationSet, error) {
+	if data.Type != core.Tick {
+		return h.Assess(data)
+	}
+
+	th, ok := h.(heuristic.TickHandler)
+	if !ok {
+		return heuristic.NoActivations(), nil
+	}
+
+	return th.HandleTick(data)
+}
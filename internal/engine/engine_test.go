@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
 	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -91,3 +95,355 @@ func TestHardCodedEngine(t *testing.T) {
 		})
 	}
 }
+
+func TestReorgRetraction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := createTestSuite(t)
+	alerts := make(chan core.Alert)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	blockHash := common.HexToHash("0x420")
+
+	store := engine.NewStore()
+	assert.NoError(t, store.AddSession(core.UUID{}, pathID, ts.mockHeuristic))
+
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 1},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), store, nil, alerts)
+
+	go func() {
+		_ = em.EventLoop()
+	}()
+
+	ts.mockHeuristic.EXPECT().Assess(gomock.Any()).
+		Return(heuristic.NewActivationSet().Add(&heuristic.Activation{Message: "activation"}), nil).Times(1)
+	ts.mockHeuristic.EXPECT().ID().Return(core.UUID{}).AnyTimes()
+	ts.mockHeuristic.EXPECT().Type().Return(core.BalanceEnforcement).AnyTimes()
+
+	// 1. Generate an alert from a block that will be orphaned
+	em.Transit() <- core.HeuristicInput{
+		PathID: pathID,
+		Input:  core.NewEvent(core.BlockHeader, nil, core.WithBlockHash(blockHash)),
+	}
+
+	alert := <-alerts
+	assert.False(t, alert.Orphaned)
+	assert.Equal(t, blockHash, alert.BlockHash)
+
+	// 2. Reorg the block out and ensure the earlier alert is retracted
+	em.Transit() <- core.HeuristicInput{
+		PathID: pathID,
+		Input: core.NewEvent(core.Reorg, core.ChainReorg{
+			Orphaned: []common.Hash{blockHash},
+		}),
+	}
+
+	retraction := <-alerts
+	assert.True(t, retraction.Orphaned)
+	assert.Equal(t, blockHash, retraction.BlockHash)
+	assert.Contains(t, retraction.Content, "activation")
+}
+
+func TestOrphanedAlertDropped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := createTestSuite(t)
+	alerts := make(chan core.Alert)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	orphaned, canonical := common.HexToHash("0x420"), common.HexToHash("0x421")
+
+	store := engine.NewStore()
+	assert.NoError(t, store.AddSession(core.UUID{}, pathID, ts.mockHeuristic))
+
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 1},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), store, nil, alerts)
+
+	go func() {
+		_ = em.EventLoop()
+	}()
+
+	ts.mockHeuristic.EXPECT().Assess(gomock.Any()).
+		Return(heuristic.NewActivationSet().Add(&heuristic.Activation{Message: "activation"}), nil).Times(2)
+	ts.mockHeuristic.EXPECT().ID().Return(core.UUID{}).AnyTimes()
+	ts.mockHeuristic.EXPECT().Type().Return(core.BalanceEnforcement).AnyTimes()
+
+	// 1. Reorg a block out before its assessment completes
+	em.Transit() <- core.HeuristicInput{
+		PathID: pathID,
+		Input: core.NewEvent(core.Reorg, core.ChainReorg{
+			Orphaned: []common.Hash{orphaned},
+		}),
+	}
+
+	em.Transit() <- core.HeuristicInput{
+		PathID: pathID,
+		Input:  core.NewEvent(core.BlockHeader, nil, core.WithBlockHash(orphaned)),
+	}
+
+	// 2. Ensure only the alert of the canonical block is emitted
+	em.Transit() <- core.HeuristicInput{
+		PathID: pathID,
+		Input:  core.NewEvent(core.BlockHeader, nil, core.WithBlockHash(canonical)),
+	}
+
+	alert := <-alerts
+	assert.False(t, alert.Orphaned)
+	assert.Equal(t, canonical, alert.BlockHash)
+}
+
+func TestDeleteHeuristicSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ss := state.NewMemState()
+	ctx = context.WithValue(ctx, core.State, ss)
+
+	alerts := make(chan core.Alert)
+	store := engine.NewStore()
+
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 0},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), store,
+		registry.NewHeuristicTable(), alerts)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	sk := core.MakeStateKey(core.Log, "addresses", true)
+
+	deploy := func() core.UUID {
+		params := core.NewSessionParams(core.Layer1)
+		params.SetValue(core.AddressKey, "0x420")
+		params.SetNestedArg("Transfer(address,address,uint256)")
+
+		id, err := em.DeployHeuristic(&heuristic.DeployConfig{
+			Stateful:      true,
+			StateKey:      sk.Clone(),
+			Network:       core.Layer1,
+			PathID:        pathID,
+			HeuristicType: core.ContractEvent,
+			Params:        params,
+		})
+		assert.NoError(t, err)
+
+		return id
+	}
+
+	id1 := deploy()
+	id2 := deploy()
+
+	pathKey := sk.Clone()
+	assert.NoError(t, pathKey.SetPathID(pathID))
+
+	// 1. Deleting a session should retain shared state used by other sessions
+	actualPath, err := em.DeleteHeuristicSession(id1)
+	assert.NoError(t, err)
+	assert.Equal(t, pathID, actualPath)
+	assert.Equal(t, []core.UUID{id2}, em.GetPathSessions(pathID))
+
+	addrs, err := ss.GetSlice(ctx, pathKey)
+	assert.NoError(t, err)
+	assert.Len(t, addrs, 1)
+
+	innerKey := &core.StateKey{
+		Prefix: sk.Prefix,
+		ID:     addrs[0],
+		PathID: &pathID,
+	}
+
+	sigs, err := ss.GetSlice(ctx, innerKey)
+	assert.NoError(t, err)
+	assert.Len(t, sigs, 1)
+
+	// 2. Deleting the final session should remove all shared state
+	_, err = em.DeleteHeuristicSession(id2)
+	assert.NoError(t, err)
+	assert.Empty(t, em.GetPathSessions(pathID))
+
+	_, err = ss.GetSlice(ctx, pathKey)
+	assert.Error(t, err)
+
+	_, err = ss.GetSlice(ctx, innerKey)
+	assert.Error(t, err)
+
+	// 3. Deleting an unknown session should fail
+	_, err = em.DeleteHeuristicSession(id1)
+	assert.Error(t, err)
+}
+
+func TestUpdateHeuristicSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ss := state.NewMemState()
+	ctx = context.WithValue(ctx, core.State, ss)
+
+	alerts := make(chan core.Alert)
+	store := engine.NewStore()
+
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 0},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), store,
+		registry.NewHeuristicTable(), alerts)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	sk := core.MakeStateKey(core.Log, "addresses", true)
+
+	newParams := func(addr string, sigs ...string) *core.SessionParams {
+		params := core.NewSessionParams(core.Layer1)
+		params.SetValue(core.AddressKey, addr)
+		for _, sig := range sigs {
+			params.SetNestedArg(sig)
+		}
+
+		return params
+	}
+
+	id, err := em.DeployHeuristic(&heuristic.DeployConfig{
+		Stateful:      true,
+		StateKey:      sk.Clone(),
+		Network:       core.Layer1,
+		PathID:        pathID,
+		HeuristicType: core.ContractEvent,
+		Params:        newParams("0x420", "Transfer(address,address,uint256)"),
+	})
+	assert.NoError(t, err)
+
+	pathKey := sk.Clone()
+	assert.NoError(t, pathKey.SetPathID(pathID))
+
+	addrKey := func(addr string) *core.StateKey {
+		return &core.StateKey{
+			Prefix: sk.Prefix,
+			ID:     common.HexToAddress(addr).String(),
+			PathID: &pathID,
+		}
+	}
+
+	// 1. Updating event signatures should swap the nested state entries
+	err = em.UpdateHeuristicSession(id, newParams("0x420", "Approval(address,address,uint256)"))
+	assert.NoError(t, err)
+
+	sigs, err := ss.GetSlice(ctx, addrKey("0x420"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Approval(address,address,uint256)"}, sigs)
+
+	h, err := store.GetHeuristic(id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, h.ID())
+	assert.Equal(t, []core.UUID{id}, em.GetPathSessions(pathID))
+
+	// 2. Updating the address should move the session's state entries
+	err = em.UpdateHeuristicSession(id, newParams("0x421", "Approval(address,address,uint256)"))
+	assert.NoError(t, err)
+
+	addrs, err := ss.GetSlice(ctx, pathKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.HexToAddress("0x421").String()}, addrs)
+
+	_, err = ss.GetSlice(ctx, addrKey("0x420"))
+	assert.Error(t, err)
+
+	// 3. Invalid params should be rejected without modifying the session
+	err = em.UpdateHeuristicSession(id, newParams("0x421"))
+	assert.Error(t, err)
+
+	cfg, err := store.GetConfig(id)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x421"), cfg.Params.Address())
+	assert.Len(t, cfg.Params.NestedArgs(), 1)
+}
+
+func TestDeployHeuristicWithID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx = context.WithValue(ctx, core.State, state.NewMemState())
+
+	alerts := make(chan core.Alert)
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 0},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), engine.NewStore(),
+		registry.NewHeuristicTable(), alerts)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+
+	params := core.NewSessionParams(core.Layer1)
+	params.SetValue(core.AddressKey, "0x420")
+	params.SetNestedArg("Transfer(address,address,uint256)")
+
+	// Restored sessions should be deployed using their original UUID
+	expected := core.NewUUID()
+	id, err := em.DeployHeuristic(&heuristic.DeployConfig{
+		ID:            expected,
+		Stateful:      true,
+		StateKey:      core.MakeStateKey(core.Log, "addresses", true),
+		Network:       core.Layer1,
+		PathID:        pathID,
+		HeuristicType: core.ContractEvent,
+		Params:        params,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, id)
+	assert.Equal(t, []core.UUID{expected}, em.GetPathSessions(pathID))
+}
+
+// tickHeuristic ... Heuristic that activates on every tick
+type tickHeuristic struct {
+	heuristic.Heuristic
+}
+
+func (th *tickHeuristic) HandleTick(tick core.Event) (*heuristic.ActivationSet, error) {
+	return heuristic.NewActivationSet().Add(&heuristic.Activation{Message: tick.Network.String()}), nil
+}
+
+func TestTickHeuristics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	alerts := make(chan core.Alert)
+	store := engine.NewStore()
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+
+	// 1. Sessions that don't handle ticks are never assessed by the ticker
+	ts := createTestSuite(t)
+	assert.NoError(t, store.AddSession(core.NewUUID(), pathID, ts.mockHeuristic))
+
+	id := core.NewUUID()
+	h := &tickHeuristic{Heuristic: heuristic.New(core.BlockHeader, core.ChainLiveness)}
+	h.SetID(id)
+
+	assert.NoError(t, store.AddSession(id, pathID, h))
+	assert.NoError(t, store.SetConfig(id, &heuristic.DeployConfig{Network: core.Layer2, PathID: pathID}))
+
+	em := engine.NewManager(ctx, &engine.Config{WorkerCount: 1, TickInterval: 10 * time.Millisecond},
+		engine.NewHardCodedEngine(alerts), engine.NewAddressMap(), store, nil, alerts)
+
+	go func() {
+		_ = em.EventLoop()
+	}()
+
+	// 2. Tick driven sessions are assessed without any ETL input
+	alert := <-alerts
+	assert.Equal(t, id, alert.HeuristicID)
+	assert.Equal(t, core.ChainLiveness, alert.HT)
+	assert.Equal(t, pathID, alert.PathID)
+	assert.Equal(t, core.Layer2.String(), alert.Content)
+
+	// 3. Sessions deleted while the ticker runs are no longer assessed
+	assert.NoError(t, store.RemoveInvSession(id, pathID))
+
+	quiet := false
+	for !quiet {
+		select {
+		case <-alerts: // Ticks sent before the deletion
+		case <-time.After(100 * time.Millisecond):
+			quiet = true
+		}
+	}
+}
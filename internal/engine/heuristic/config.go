@@ -4,6 +4,9 @@ import "github.com/base-org/pessimism/internal/core"
 
 // DeployConfig ... Configuration for deploying a heuristic session
 type DeployConfig struct {
+	// ID ... Optional session UUID used when restoring a previously deployed session
+	ID core.UUID
+
 	Stateful bool
 	StateKey *core.StateKey
 
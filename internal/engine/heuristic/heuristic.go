@@ -28,6 +28,25 @@ type Heuristic interface {
 	SetID(core.UUID)
 }
 
+// ReorgHandler ... Optional interface implemented by heuristics that retain
+// state derived from previously assessed blocks and must discard it on reorg
+type ReorgHandler interface {
+	HandleReorg(reorg core.ChainReorg) error
+}
+
+// TickHandler ... Optional interface implemented by heuristics that are periodically
+// assessed by the engine, allowing them to alert on the absence of input (e.g. a chain halt)
+type TickHandler interface {
+	HandleTick(tick core.Event) (*ActivationSet, error)
+}
+
+// StateInheritor ... Optional interface implemented by heuristics that accumulate state
+// across assessments. When a session's params are updated, the rebuilt instance inherits
+// the state of the instance it replaces so that alerts aren't missed or duplicated
+type StateInheritor interface {
+	Inherit(prev Heuristic)
+}
+
 type BaseHeuristicOpt = func(bh *BaseHeuristic) *BaseHeuristic
 
 type BaseHeuristic struct {
@@ -5,6 +5,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
@@ -12,12 +13,21 @@ import (
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/base-org/pessimism/internal/metrics"
 	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/common"
 
 	"go.uber.org/zap"
 )
 
+const (
+	orphanedAlertFmt = "Activation was based on block %s which has been orphaned by a chain reorganization.\nOriginal assessment:\n%s"
+
+	defaultTickInterval = time.Second
+)
+
 type Config struct {
 	WorkerCount int
+	// TickInterval ... Interval at which tick driven heuristics are assessed
+	TickInterval time.Duration
 }
 
 // Manager ... Engine manager interface
@@ -25,8 +35,12 @@ type Manager interface {
 	GetInputType(ht core.HeuristicType) (core.TopicType, error)
 	Transit() chan core.HeuristicInput
 
-	DeleteHeuristicSession(core.UUID) (core.UUID, error)
+	DeleteHeuristicSession(core.UUID) (core.PathID, error)
 	DeployHeuristic(cfg *heuristic.DeployConfig) (core.UUID, error)
+	GetPathSessions(core.PathID) []core.UUID
+	GetSessions() []core.UUID
+	GetSessionConfig(core.UUID) (*heuristic.DeployConfig, error)
+	UpdateHeuristicSession(core.UUID, *core.SessionParams) error
 
 	core.Subsystem
 }
@@ -49,6 +63,8 @@ type engineManager struct {
 	// Used to send execution requests to engine worker subscribers
 	workerEgress chan ExecInput
 
+	tickInterval time.Duration
+
 	metrics    metrics.Metricer
 	engine     RiskEngine
 	addressing *AddressMap
@@ -72,6 +88,11 @@ func NewManager(ctx context.Context, cfg *Config, engine RiskEngine, addr *Addre
 		store:        store,
 		heuristics:   it,
 		metrics:      metrics.WithContext(ctx),
+		tickInterval: cfg.TickInterval,
+	}
+
+	if em.tickInterval <= 0 {
+		em.tickInterval = defaultTickInterval
 	}
 
 	// Start engine worker pool for concurrent heuristic execution
@@ -91,16 +112,233 @@ func (em *engineManager) Transit() chan core.HeuristicInput {
 	return em.etlIngress
 }
 
-// DeleteHeuristicSession ... Deletes a heuristic session
-func (em *engineManager) DeleteHeuristicSession(_ core.UUID) (core.UUID, error) {
-	return core.UUID{}, nil
+// DeleteHeuristicSession ... Deletes a heuristic session and its shared state,
+// returning the ID of the path that the session was bound to
+func (em *engineManager) DeleteHeuristicSession(id core.UUID) (core.PathID, error) {
+	cfg, err := em.store.GetConfig(id)
+	if err != nil {
+		return core.PathID{}, err
+	}
+
+	if cfg.Stateful {
+		if err := em.removeSharedState(id, cfg); err != nil {
+			return core.PathID{}, err
+		}
+	}
+
+	if err := em.store.RemoveInvSession(id, cfg.PathID); err != nil {
+		return core.PathID{}, err
+	}
+
+	em.metrics.DecActiveHeuristics(cfg.HeuristicType, cfg.Network)
+
+	logging.WithContext(em.ctx).Info("Deleted heuristic session",
+		zap.String(logging.UUID, id.String()),
+		zap.String(logging.Path, cfg.PathID.String()))
+
+	return cfg.PathID, nil
+}
+
+// UpdateHeuristicSession ... Swaps the params of an existing heuristic session
+// in place, retaining the session's UUID and path. The rebuilt instance inherits the
+// state of the replaced one when it implements heuristic.StateInheritor
+func (em *engineManager) UpdateHeuristicSession(id core.UUID, params *core.SessionParams) error {
+	cfg, err := em.store.GetConfig(id)
+	if err != nil {
+		return err
+	}
+
+	h, exists := em.heuristics[cfg.HeuristicType]
+	if !exists {
+		return fmt.Errorf("heuristic type %s not found", cfg.HeuristicType)
+	}
+
+	// Session network is bound to the path and cannot be changed
+	params.Net = cfg.Network
+
+	if h.PrepareValidate != nil {
+		err = h.PrepareValidate(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	instance, err := h.Constructor(em.ctx, params)
+	if err != nil {
+		return err
+	}
+
+	instance.SetID(id)
+
+	updated := *cfg
+	updated.Params = params
+
+	if err = em.store.UpdateSession(id, instance); err != nil {
+		return err
+	}
+
+	if err = em.store.SetConfig(id, &updated); err != nil {
+		return err
+	}
+
+	if cfg.Stateful {
+		if err = em.swapSharedState(id, cfg, &updated); err != nil {
+			return err
+		}
+	}
+
+	logging.WithContext(em.ctx).Info("Updated heuristic session",
+		zap.String(logging.UUID, id.String()),
+		zap.String(logging.Path, cfg.PathID.String()))
+
+	return nil
+}
+
+// swapSharedState ... Replaces a session's shared state entries with those of its updated config.
+// New entries are inserted before stale ones are pruned so that no block is read without them
+func (em *engineManager) swapSharedState(id core.UUID, prev, next *heuristic.DeployConfig) error {
+	prevAddr, nextAddr := prev.Params.Address(), next.Params.Address()
+
+	if prevAddr != nextAddr {
+		if err := em.addressing.Insert(nextAddr, next.PathID, id); err != nil {
+			return err
+		}
+
+		if err := em.updateSharedState(next.Params, next.StateKey, next.PathID); err != nil {
+			return err
+		}
+
+		return em.removeSharedState(id, prev)
+	}
+
+	if err := em.updateSharedState(next.Params, next.StateKey, next.PathID); err != nil {
+		return err
+	}
+
+	return em.pruneNestedState(prevAddr, prev.PathID, prev.StateKey)
+}
+
+// pruneNestedState ... Removes nested state entries for an address that are no
+// longer used by any of the heuristic sessions monitoring it on the path
+func (em *engineManager) pruneNestedState(addr common.Address, id core.PathID, sk *core.StateKey) error {
+	if !sk.IsNested() {
+		return nil
+	}
+
+	ids, err := em.addressing.Get(addr, id)
+	if err != nil {
+		return err
+	}
+
+	inUse := make(map[string]struct{})
+	for _, uuid := range ids {
+		cfg, err := em.store.GetConfig(uuid)
+		if err != nil {
+			return err
+		}
+
+		for _, arg := range cfg.Params.NestedArgs() {
+			if argStr, ok := arg.(string); ok {
+				inUse[argStr] = struct{}{}
+			}
+		}
+	}
+
+	ss, err := state.FromContext(em.ctx)
+	if err != nil {
+		return err
+	}
+
+	innerKey := nestedKey(sk, addr, id)
+
+	entries, err := ss.GetSlice(em.ctx, innerKey)
+	if err != nil { // Nothing to prune
+		return nil
+	}
+
+	for _, entry := range entries {
+		if _, found := inUse[entry]; found {
+			continue
+		}
+
+		if err := ss.RemoveSliceValue(em.ctx, innerKey, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nestedKey ... Builds the nested state key for an address on a path
+func nestedKey(sk *core.StateKey, addr common.Address, id core.PathID) *core.StateKey {
+	return &core.StateKey{
+		Nesting: false,
+		Prefix:  sk.Prefix,
+		ID:      addr.String(),
+		PathID:  &id,
+	}
+}
+
+// GetPathSessions ... Returns the heuristic sessions that are bound to a path
+func (em *engineManager) GetPathSessions(id core.PathID) []core.UUID {
+	ids, err := em.store.GetIDs(id)
+	if err != nil {
+		return []core.UUID{}
+	}
+
+	return ids
+}
+
+// GetSessions ... Returns the UUIDs of all deployed heuristic sessions
+func (em *engineManager) GetSessions() []core.UUID {
+	return em.store.GetSessionIDs()
+}
+
+// GetSessionConfig ... Returns the deploy config of a heuristic session
+func (em *engineManager) GetSessionConfig(id core.UUID) (*heuristic.DeployConfig, error) {
+	return em.store.GetConfig(id)
+}
+
+// removeSharedState ... Removes a session's address entries from the shared state store.
+// Entries are only removed once no other session on the path monitors the address
+func (em *engineManager) removeSharedState(id core.UUID, cfg *heuristic.DeployConfig) error {
+	addr := cfg.Params.Address()
+
+	if err := em.addressing.Remove(addr, cfg.PathID, id); err != nil {
+		return err
+	}
+
+	if ids, err := em.addressing.Get(addr, cfg.PathID); err == nil && len(ids) > 0 {
+		return em.pruneNestedState(addr, cfg.PathID, cfg.StateKey)
+	}
+
+	if err := state.RemoveEntry(em.ctx, cfg.StateKey, addr.String()); err != nil {
+		return err
+	}
+
+	if cfg.StateKey.IsNested() {
+		ss, err := state.FromContext(em.ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := ss.Remove(em.ctx, nestedKey(cfg.StateKey, addr, cfg.PathID)); err != nil {
+			return err
+		}
+	}
+
+	logging.WithContext(em.ctx).Debug("Removed from state store",
+		zap.String(logging.Path, cfg.PathID.String()),
+		zap.String(logging.AddrKey, addr.String()))
+
+	return nil
 }
 
 func (em *engineManager) updateSharedState(params *core.SessionParams,
 	sk *core.StateKey, id core.PathID) error {
 	err := sk.SetPathID(id)
 	// PathID already exists in key but is different than the one we want
-	if err != nil && sk.PathID != &id {
+	if err != nil && *sk.PathID != id {
 		return err
 	}
 
@@ -117,15 +355,7 @@ func (em *engineManager) updateSharedState(params *core.SessionParams,
 				return fmt.Errorf("invalid event string")
 			}
 
-			// Build nested key
-			innerKey := &core.StateKey{
-				Nesting: false,
-				Prefix:  sk.Prefix,
-				ID:      params.Address().String(),
-				PathID:  &id,
-			}
-
-			err = state.InsertUnique(em.ctx, innerKey, argStr)
+			err = state.InsertUnique(em.ctx, nestedKey(sk, params.Address(), id), argStr)
 			if err != nil {
 				return err
 			}
@@ -152,7 +382,11 @@ func (em *engineManager) DeployHeuristic(cfg *heuristic.DeployConfig) (core.UUID
 		}
 	}
 
-	id := core.NewUUID()
+	id := cfg.ID
+	if id == (core.UUID{}) {
+		id = core.NewUUID()
+	}
+
 	// Build heuristic instance using constructor functions from data topic definitions
 	instance, err := h.Constructor(em.ctx, cfg.Params)
 	if err != nil {
@@ -166,6 +400,11 @@ func (em *engineManager) DeployHeuristic(cfg *heuristic.DeployConfig) (core.UUID
 		return core.UUID{}, err
 	}
 
+	err = em.store.SetConfig(id, cfg)
+	if err != nil {
+		return core.UUID{}, err
+	}
+
 	// Shared subsystem state management
 	if cfg.Stateful {
 		err = em.addressing.Insert(cfg.Params.Address(), cfg.PathID, id)
@@ -188,12 +427,23 @@ func (em *engineManager) DeployHeuristic(cfg *heuristic.DeployConfig) (core.UUID
 func (em *engineManager) EventLoop() error {
 	logger := logging.WithContext(em.ctx)
 
+	ticker := time.NewTicker(em.tickInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
+		case now := <-ticker.C: // Periodic assessment
+			em.tickHeuristics(em.ctx, now)
+
 		case data := <-em.etlIngress: // ETL transit
 			logger.Debug("Received heuristic input",
 				zap.String("input", fmt.Sprintf("%+v", data)))
 
+			if data.Input.Type == core.Reorg {
+				em.handleReorg(em.ctx, data)
+				continue
+			}
+
 			em.executeHeuristics(em.ctx, data)
 
 		case <-em.ctx.Done(): // Shutdown
@@ -219,6 +469,54 @@ func (em *engineManager) Shutdown() error {
 	return nil
 }
 
+// handleReorg ... Notifies reorg aware heuristics on the path and retracts
+// any earlier activations that were based on orphaned blocks
+func (em *engineManager) handleReorg(ctx context.Context, data core.HeuristicInput) {
+	logger := logging.WithContext(ctx)
+
+	reorg, ok := data.Input.Value.(core.ChainReorg)
+	if !ok {
+		logger.Error("Could not cast reorg notification",
+			zap.String(logging.Path, data.PathID.String()))
+		return
+	}
+
+	logger.Warn("Received reorg notification",
+		zap.String(logging.Path, data.PathID.String()),
+		zap.Int("depth", reorg.Depth()))
+
+	ids, err := em.store.GetIDs(data.PathID)
+	if err == nil {
+		heuristics, err := em.store.GetHeuristics(ids)
+		if err != nil {
+			logger.Error("Could not fetch heuristics for path",
+				zap.Error(err),
+				zap.String(logging.Path, data.PathID.String()))
+		}
+
+		for _, h := range heuristics {
+			rh, ok := h.(heuristic.ReorgHandler)
+			if !ok {
+				continue
+			}
+
+			if err := rh.HandleReorg(reorg); err != nil {
+				logger.Error("Heuristic failed to handle reorg",
+					zap.Error(err),
+					zap.String(logging.UUID, h.ID().String()))
+			}
+		}
+	}
+
+	for _, alert := range em.engine.OrphanedActivations(data.PathID, reorg) {
+		alert.Orphaned = true
+		alert.Timestamp = time.Now()
+		alert.Content = fmt.Sprintf(orphanedAlertFmt, alert.BlockHash.String(), alert.Content)
+
+		em.alertEgress <- alert
+	}
+}
+
 func (em *engineManager) executeHeuristics(ctx context.Context, data core.HeuristicInput) {
 	if data.Input.Addressed() {
 		em.executeAddressHeuristics(ctx, data)
@@ -273,6 +571,19 @@ func (em *engineManager) executeNonAddressHeuristics(ctx context.Context, data c
 	}
 }
 
+// tickHeuristics ... Sends a tick event to every heuristic session that handles ticks.
+// Sessions are read from a store snapshot since they can be deployed, updated or
+// deleted by API handlers while ticks are being sent
+func (em *engineManager) tickHeuristics(ctx context.Context, now time.Time) {
+	for _, ts := range em.store.tickSessions() {
+		tick := core.NewEvent(core.Tick, now)
+		tick.Timestamp = now
+		tick.Network = ts.cfg.Network
+
+		em.executeHeuristic(ctx, core.HeuristicInput{PathID: ts.cfg.PathID, Input: tick}, ts.h)
+	}
+}
+
 // executeHeuristic ... Sends heuristic input to engine worker pool for execution
 func (em *engineManager) executeHeuristic(ctx context.Context, data core.HeuristicInput, h heuristic.Heuristic) {
 	ei := ExecInput{
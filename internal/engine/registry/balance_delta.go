@@ -0,0 +1,269 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/common/math"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BalanceDeltaCfg ... Configuration for the balance delta heuristic. Changes are measured
+// over the blocks that are within both the block and time windows that are provided
+type BalanceDeltaCfg struct {
+	Address string `json:"address"`
+
+	// WindowBlocks ... Number of most recent blocks to measure changes over
+	WindowBlocks uint64 `json:"window_blocks"`
+	// WindowSeconds ... Number of seconds of most recent blocks to measure changes over
+	WindowSeconds uint64 `json:"window_seconds"`
+
+	// MaxOutflow & MaxInflow ... Absolute ETH thresholds
+	MaxOutflow *float64 `json:"max_outflow"`
+	MaxInflow  *float64 `json:"max_inflow"`
+	// MaxOutflowPercent & MaxInflowPercent ... Percentage thresholds relative to the
+	// highest and lowest balance in the window respectively
+	MaxOutflowPercent *float64 `json:"max_outflow_percent"`
+	MaxInflowPercent  *float64 `json:"max_inflow_percent"`
+}
+
+// Unmarshal ... Converts a general config to a balance delta heuristic config
+func (bdc *BalanceDeltaCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &bdc)
+}
+
+// balanceSample ... Balance of an address at some block
+type balanceSample struct {
+	height  uint64
+	time    uint64
+	balance *big.Int
+}
+
+// BalanceDeltaHeuristic ... Heuristic that alerts on large balance changes over a rolling window
+type BalanceDeltaHeuristic struct {
+	ctx context.Context
+	cfg *BalanceDeltaCfg
+
+	mu      sync.Mutex
+	history []balanceSample
+
+	heuristic.Heuristic
+}
+
+// balanceDeltaMsg ... Message to be sent to the alerting subsystem
+const balanceDeltaMsg = `
+	Current balance: %s ETH
+	Window: blocks %d to %d
+	Outflow: %s ETH (%s%%)
+	Inflow: %s ETH (%s%%)
+	Violations: %s
+
+	Session UUID: %s
+	Session Address: %s
+`
+
+// NewBalanceDeltaHeuristic ... Initializer
+func NewBalanceDeltaHeuristic(ctx context.Context, cfg *BalanceDeltaCfg) (heuristic.Heuristic, error) {
+	if cfg.WindowBlocks == 0 && cfg.WindowSeconds == 0 {
+		return nil, fmt.Errorf(noWindowErr)
+	}
+
+	if cfg.MaxOutflow == nil && cfg.MaxInflow == nil &&
+		cfg.MaxOutflowPercent == nil && cfg.MaxInflowPercent == nil {
+		return nil, fmt.Errorf(noThresholdErr)
+	}
+
+	return &BalanceDeltaHeuristic{
+		ctx:       ctx,
+		cfg:       cfg,
+		history:   make([]balanceSample, 0),
+		Heuristic: heuristic.New(core.BlockHeader, core.BalanceDelta),
+	}, nil
+}
+
+// record ... Inserts a sample into the history in height order and prunes the samples
+// that are outside of the window ending at the latest sample. Samples can arrive out of
+// order since headers are assessed concurrently, so a sample for an already recorded
+// height replaces it. False is returned if the sample is already outside of the window
+func (bdh *BalanceDeltaHeuristic) record(s balanceSample) ([]balanceSample, bool) {
+	bdh.mu.Lock()
+	defer bdh.mu.Unlock()
+
+	i := sort.Search(len(bdh.history), func(i int) bool {
+		return bdh.history[i].height >= s.height
+	})
+
+	if i < len(bdh.history) && bdh.history[i].height == s.height {
+		bdh.history[i] = s
+	} else {
+		history := make([]balanceSample, 0, len(bdh.history)+1)
+		history = append(history, bdh.history[:i]...)
+		history = append(history, s)
+		bdh.history = append(history, bdh.history[i:]...)
+	}
+
+	latest := bdh.history[len(bdh.history)-1]
+	start := 0
+	for start < len(bdh.history) && !bdh.inWindow(bdh.history[start], latest) {
+		start++
+	}
+	bdh.history = bdh.history[start:]
+
+	if !bdh.inWindow(s, latest) {
+		return nil, false
+	}
+
+	window := make([]balanceSample, len(bdh.history))
+	copy(window, bdh.history)
+	return window, true
+}
+
+// inWindow ... Returns true if a sample is within the window ending at the latest sample
+func (bdh *BalanceDeltaHeuristic) inWindow(s, latest balanceSample) bool {
+	if bdh.cfg.WindowBlocks > 0 && latest.height > s.height+bdh.cfg.WindowBlocks {
+		return false
+	}
+
+	if bdh.cfg.WindowSeconds > 0 && latest.time > s.time+bdh.cfg.WindowSeconds {
+		return false
+	}
+
+	return true
+}
+
+// HandleReorg ... Discards the samples of orphaned blocks
+func (bdh *BalanceDeltaHeuristic) HandleReorg(reorg core.ChainReorg) error {
+	if reorg.AncestorHeight == nil {
+		return nil
+	}
+
+	bdh.mu.Lock()
+	defer bdh.mu.Unlock()
+
+	ancestor := reorg.AncestorHeight.Uint64()
+	for i, s := range bdh.history {
+		if s.height > ancestor {
+			bdh.history = bdh.history[:i]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Inherit ... Carries over the balance history of the replaced session when it
+// monitors the same address. Samples outside of an updated window are pruned on the next block
+func (bdh *BalanceDeltaHeuristic) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*BalanceDeltaHeuristic)
+	if !ok || common.HexToAddress(p.cfg.Address) != common.HexToAddress(bdh.cfg.Address) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bdh.history = append([]balanceSample(nil), p.history...)
+}
+
+// Assess ... Checks if the balance changed by more than the thresholds over the window
+func (bdh *BalanceDeltaHeuristic) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	header, ok := e.Value.(types.Header)
+	if !ok {
+		return nil, fmt.Errorf(couldNotCastErr, "BlockHeader")
+	}
+
+	client, err := client.FromNetwork(bdh.ctx, e.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Fetch the balance and add it to the window
+	balance, err := client.BalanceAt(bdh.ctx, common.HexToAddress(bdh.cfg.Address), header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	window, ok := bdh.record(balanceSample{height: header.Number.Uint64(), time: header.Time, balance: balance})
+	if !ok || len(window) < 2 {
+		return heuristic.NoActivations(), nil
+	}
+
+	// The window is measured up to its latest sample, which may be newer than this
+	// header's when headers are assessed out of order
+	current := window[len(window)-1]
+	balance = current.balance
+
+	// 2. Measure the outflow from the window's peak and the inflow from its trough
+	peak, trough := window[0].balance, window[0].balance
+	for _, s := range window[1:] {
+		if s.balance.Cmp(peak) > 0 {
+			peak = s.balance
+		}
+
+		if s.balance.Cmp(trough) < 0 {
+			trough = s.balance
+		}
+	}
+
+	outflow := math.WeiToEther(new(big.Int).Sub(peak, balance))
+	inflow := math.WeiToEther(new(big.Int).Sub(balance, trough))
+	outflowPct := percentChange(outflow, math.WeiToEther(peak))
+	inflowPct := percentChange(inflow, math.WeiToEther(trough))
+
+	// 3. Compare the changes to the thresholds
+	violations := make([]string, 0)
+	violations = appendExceeded(violations, "outflow", outflow, bdh.cfg.MaxOutflow, " ETH")
+	violations = appendExceeded(violations, "inflow", inflow, bdh.cfg.MaxInflow, " ETH")
+	violations = appendExceeded(violations, "outflow", outflowPct, bdh.cfg.MaxOutflowPercent, "%")
+	violations = appendExceeded(violations, "inflow", inflowPct, bdh.cfg.MaxInflowPercent, "%")
+
+	if len(violations) == 0 {
+		return heuristic.NoActivations(), nil
+	}
+
+	msg := fmt.Sprintf(balanceDeltaMsg, math.WeiToEther(balance).Text('f', -1),
+		window[0].height, current.height,
+		outflow.Text('f', -1), outflowPct.Text('f', 2),
+		inflow.Text('f', -1), inflowPct.Text('f', 2),
+		strings.Join(violations, "; "), bdh.ID(), bdh.cfg.Address)
+
+	return heuristic.NewActivationSet().Add(
+		&heuristic.Activation{
+			Message:   msg,
+			TimeStamp: time.Now(),
+		}), nil
+}
+
+// percentChange ... Returns the percentage a change is of some base. A change
+// from a zero base is treated as a 100% change
+func percentChange(change, base *big.Float) *big.Float {
+	if change.Sign() == 0 {
+		return new(big.Float)
+	}
+
+	if base.Sign() == 0 {
+		return big.NewFloat(100)
+	}
+
+	return math.PercentOf(change, base)
+}
+
+// appendExceeded ... Appends a violation if a change exceeds an optional threshold
+func appendExceeded(violations []string, name string, change *big.Float,
+	threshold *float64, unit string) []string {
+	if threshold == nil || change.Cmp(big.NewFloat(*threshold)) <= 0 {
+		return violations
+	}
+
+	return append(violations, fmt.Sprintf("%s exceeds %v%s", name, *threshold, unit))
+}
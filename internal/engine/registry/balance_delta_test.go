@@ -0,0 +1,194 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceDelta(t *testing.T) {
+	addr := common.HexToAddress("0x123")
+	f := func(v float64) *float64 { return &v }
+
+	var tests = []struct {
+		name      string
+		cfg       *registry.BalanceDeltaCfg
+		balances  []int64 // ETH per block
+		activated []bool
+	}{
+		{
+			name:      "Activate on a gradual drain within the block window",
+			cfg:       &registry.BalanceDeltaCfg{WindowBlocks: 3, MaxOutflow: f(2.5)},
+			balances:  []int64{10, 9, 8, 7, 6},
+			activated: []bool{false, false, false, true, true},
+		},
+		{
+			name:      "No activation once the drain leaves the block window",
+			cfg:       &registry.BalanceDeltaCfg{WindowBlocks: 1, MaxOutflow: f(1.5)},
+			balances:  []int64{10, 9, 8, 7},
+			activated: []bool{false, false, false, false},
+		},
+		{
+			name:      "Activate on a percentage inflow within the time window",
+			cfg:       &registry.BalanceDeltaCfg{WindowSeconds: 36, MaxInflowPercent: f(50)},
+			balances:  []int64{10, 12, 14, 16},
+			activated: []bool{false, false, false, true},
+		},
+		{
+			name:      "Inflows don't trigger outflow thresholds",
+			cfg:       &registry.BalanceDeltaCfg{WindowBlocks: 10, MaxOutflow: f(1), MaxOutflowPercent: f(1)},
+			balances:  []int64{1, 5, 10},
+			activated: []bool{false, false, false},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			test.cfg.Address = addr.String()
+			h, err := registry.NewBalanceDeltaHeuristic(ctx, test.cfg)
+			assert.NoError(t, err)
+
+			for j, bal := range test.balances {
+				num := big.NewInt(int64(j + 1))
+				wei := new(big.Int).Mul(big.NewInt(bal), big.NewInt(params.Ether))
+
+				ms.MockL1.EXPECT().BalanceAt(ctx, addr, num).Return(wei, nil).Times(1)
+
+				as, err := h.Assess(core.Event{
+					Network: core.Layer1,
+					Type:    core.BlockHeader,
+					Value:   types.Header{Number: num, Time: uint64(j * 12)},
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, test.activated[j], as.Activated(), "block %d", j+1)
+			}
+		})
+	}
+}
+
+func TestBalanceDeltaReorg(t *testing.T) {
+	addr := common.HexToAddress("0x123")
+	threshold := float64(1.5)
+
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+	h, err := registry.NewBalanceDeltaHeuristic(ctx, &registry.BalanceDeltaCfg{
+		Address:      addr.String(),
+		WindowBlocks: 10,
+		MaxOutflow:   &threshold,
+	})
+	assert.NoError(t, err)
+
+	assess := func(height, eth int64) bool {
+		num := big.NewInt(height)
+		ms.MockL1.EXPECT().BalanceAt(ctx, addr, num).
+			Return(new(big.Int).Mul(big.NewInt(eth), big.NewInt(params.Ether)), nil).Times(1)
+
+		as, err := h.Assess(core.Event{Network: core.Layer1, Type: core.BlockHeader, Value: types.Header{Number: num}})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	assert.False(t, assess(1, 5))
+	assert.False(t, assess(2, 10)) // Orphaned peak
+
+	rh, ok := h.(heuristic.ReorgHandler)
+	assert.True(t, ok)
+	assert.NoError(t, rh.HandleReorg(core.ChainReorg{AncestorHeight: big.NewInt(1)}))
+
+	// The orphaned peak is no longer used to measure the outflow
+	assert.False(t, assess(2, 4))
+}
+
+func TestBalanceDeltaOutOfOrder(t *testing.T) {
+	addr := common.HexToAddress("0x123")
+	threshold := float64(1.5)
+
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+	h, err := registry.NewBalanceDeltaHeuristic(ctx, &registry.BalanceDeltaCfg{
+		Address:      addr.String(),
+		WindowBlocks: 2,
+		MaxOutflow:   &threshold,
+	})
+	assert.NoError(t, err)
+
+	assess := func(height, eth int64) bool {
+		num := big.NewInt(height)
+		ms.MockL1.EXPECT().BalanceAt(ctx, addr, num).
+			Return(new(big.Int).Mul(big.NewInt(eth), big.NewInt(params.Ether)), nil).Times(1)
+
+		as, err := h.Assess(core.Event{Network: core.Layer1, Type: core.BlockHeader, Value: types.Header{Number: num}})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	assert.False(t, assess(1, 5))
+	assert.False(t, assess(3, 4))
+	// The late peak is measured against the window's latest balance
+	assert.True(t, assess(2, 10))
+	// Samples that have already left the window are ignored
+	assert.False(t, assess(0, 20))
+}
+
+func TestBalanceDeltaInherit(t *testing.T) {
+	addr, other := common.HexToAddress("0x123"), common.HexToAddress("0x456")
+	threshold := float64(1.5)
+
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+	construct := func(a common.Address) heuristic.Heuristic {
+		h, err := registry.NewBalanceDeltaHeuristic(ctx, &registry.BalanceDeltaCfg{
+			Address:      a.String(),
+			WindowBlocks: 10,
+			MaxOutflow:   &threshold,
+		})
+		assert.NoError(t, err)
+		return h
+	}
+
+	assess := func(h heuristic.Heuristic, a common.Address, height, eth int64) bool {
+		num := big.NewInt(height)
+		ms.MockL1.EXPECT().BalanceAt(ctx, a, num).
+			Return(new(big.Int).Mul(big.NewInt(eth), big.NewInt(params.Ether)), nil).Times(1)
+
+		as, err := h.Assess(core.Event{Network: core.Layer1, Type: core.BlockHeader, Value: types.Header{Number: num}})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	prev := construct(addr)
+	assert.False(t, assess(prev, addr, 1, 10))
+
+	// 1. The history of the replaced session is used to measure the outflow
+	next := construct(addr)
+	next.(*registry.BalanceDeltaHeuristic).Inherit(prev)
+	assert.True(t, assess(next, addr, 2, 8))
+
+	// 2. History isn't carried over once the monitored address changes
+	moved := construct(other)
+	moved.(*registry.BalanceDeltaHeuristic).Inherit(next)
+	assert.False(t, assess(moved, other, 3, 8))
+}
+
+func TestBalanceDeltaConfig(t *testing.T) {
+	threshold := float64(1)
+
+	_, err := registry.NewBalanceDeltaHeuristic(context.Background(),
+		&registry.BalanceDeltaCfg{MaxOutflow: &threshold})
+	assert.Error(t, err, "failure should occur when no window is provided")
+
+	_, err = registry.NewBalanceDeltaHeuristic(context.Background(),
+		&registry.BalanceDeltaCfg{WindowBlocks: 10})
+	assert.Error(t, err, "failure should occur when no threshold is provided")
+}
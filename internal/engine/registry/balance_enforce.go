@@ -79,35 +79,12 @@ func (bi *BalanceHeuristic) Assess(e core.Event) (*heuristic.ActivationSet, erro
 
 	ethBalance, _ := math.WeiToEther(balance).Float64()
 
-	activated := false
+	// 2. Assess if balance is outside of the bounds
+	activated := outOfBounds(ethBalance, bi.cfg.UpperBound, bi.cfg.LowerBound)
 
-	// 2. Assess if balance > upper bound
-	if bi.cfg.UpperBound != nil &&
-		*bi.cfg.UpperBound < ethBalance {
-		activated = true
-	}
-
-	// 3. Assess if balance < lower bound
-	if bi.cfg.LowerBound != nil &&
-		*bi.cfg.LowerBound > ethBalance {
-		activated = true
-	}
-
-	/// 4. Generate activation outcome if activated
+	/// 3. Generate activation outcome if activated
 	if activated {
-		var upper, lower string
-
-		if bi.cfg.UpperBound != nil {
-			upper = fmt.Sprintf("%2f", *bi.cfg.UpperBound)
-		} else {
-			upper = "∞"
-		}
-
-		if bi.cfg.LowerBound != nil {
-			lower = fmt.Sprintf("%2f", *bi.cfg.LowerBound)
-		} else {
-			lower = "-∞"
-		}
+		upper, lower := formatBounds(bi.cfg.UpperBound, bi.cfg.LowerBound)
 
 		msg := fmt.Sprintf(reportMsg, balance, upper, lower, bi.ID(), bi.cfg.Address)
 
@@ -121,3 +98,24 @@ func (bi *BalanceHeuristic) Assess(e core.Event) (*heuristic.ActivationSet, erro
 	// No activation
 	return heuristic.NoActivations(), nil
 }
+
+// outOfBounds ... Returns true if a value is greater than the upper bound
+// or less than the lower bound. Nil bounds are unbounded
+func outOfBounds(val float64, upper, lower *float64) bool {
+	return (upper != nil && *upper < val) || (lower != nil && *lower > val)
+}
+
+// formatBounds ... Returns the string representations of optional upper and lower bounds
+func formatBounds(upper, lower *float64) (string, string) {
+	upperStr, lowerStr := "∞", "-∞"
+
+	if upper != nil {
+		upperStr = fmt.Sprintf("%2f", *upper)
+	}
+
+	if lower != nil {
+		lowerStr = fmt.Sprintf("%2f", *lower)
+	}
+
+	return upperStr, lowerStr
+}
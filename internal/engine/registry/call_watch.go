@@ -0,0 +1,189 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/common/math"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CallWatchCfg ... Configuration for the call watch heuristic. When no filters are
+// provided, the heuristic alerts on every call frame sent from or to the address
+type CallWatchCfg struct {
+	Address string `json:"address"`
+	// Direction ... Either `from`, `to` or empty for both
+	Direction string `json:"direction"`
+	// CallTypes ... Call frame types (e.g. CALL, DELEGATECALL) to alert on
+	CallTypes []string `json:"call_types"`
+	// Selectors ... Function selectors (e.g. 0xa9059cbb) or signatures (e.g. transfer(address,uint256))
+	Selectors []string `json:"selectors"`
+	// Threshold ... Call value (ETH) at or above which a call is alerted on
+	Threshold *float64 `json:"threshold"`
+	// Internal ... Only alert on calls made by other contracts rather than the transaction itself
+	Internal bool `json:"internal"`
+}
+
+// Unmarshal ... Converts a general config to a call watch heuristic config
+func (cwc *CallWatchCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &cwc)
+}
+
+// callWatchMsg ... Message to be sent to the alerting system when a call frame matches
+const callWatchMsg = `
+	_Call Observed_
+
+	Address: %s
+	Transaction Hash: %s
+	Call Type: %s
+	From: %s
+	To: %s
+	Value: %s ETH
+	Selector: %s
+	Depth: %d
+	Block Number: %s
+`
+
+// CallWatch ... Heuristic that monitors the call frames sent from or to an address,
+// including internal value transfers and delegate calls
+type CallWatch struct {
+	cfg *CallWatchCfg
+
+	addr      common.Address
+	types     map[string]struct{}
+	selectors [][]byte
+
+	heuristic.Heuristic
+}
+
+// NewCallWatch ... Initializer
+func NewCallWatch(cfg *CallWatchCfg) (heuristic.Heuristic, error) {
+	switch cfg.Direction {
+	case "", DirectionFrom, DirectionTo:
+	default:
+		return nil, fmt.Errorf(invalidDirectionErr, cfg.Direction)
+	}
+
+	types := make(map[string]struct{}, len(cfg.CallTypes))
+	for _, ct := range cfg.CallTypes {
+		types[strings.ToUpper(ct)] = struct{}{}
+	}
+
+	selectors := make([][]byte, 0, len(cfg.Selectors))
+	for _, s := range cfg.Selectors {
+		sel, err := parseSelector(s)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, sel)
+	}
+
+	return &CallWatch{
+		cfg:       cfg,
+		addr:      common.HexToAddress(cfg.Address),
+		types:     types,
+		selectors: selectors,
+
+		Heuristic: heuristic.New(core.Trace, core.CallWatch),
+	}, nil
+}
+
+// Assess ... Checks if a call frame sent from or to the address matches the configured filters
+func (cw *CallWatch) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	// 1. Validate and extract the call frame from the event
+	err := cw.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Address != cw.addr {
+		return nil, fmt.Errorf(invalidAddrErr, cw.cfg.Address, e.Address.String())
+	}
+
+	call, success := e.Value.(core.CallTrace)
+	if !success {
+		return nil, fmt.Errorf(couldNotCastErr, "CallTrace")
+	}
+
+	// 2. Check the call frame against the filters
+	if !cw.matches(call) {
+		return heuristic.NoActivations(), nil
+	}
+
+	value := call.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	selector := "none"
+	if call.Selector != nil {
+		selector = hexutil.Encode(call.Selector)
+	}
+
+	return heuristic.NewActivationSet().Add(&heuristic.Activation{
+		TimeStamp: time.Now(),
+		Message: fmt.Sprintf(callWatchMsg, cw.cfg.Address, call.TxHash, call.Type, call.From, call.To,
+			math.WeiToEther(value).String(), selector, call.Depth, call.BlockNumber),
+	}), nil
+}
+
+// matches ... Returns true if a call frame holds for every configured filter.
+// Reverted call frames are never matched since they had no effect
+func (cw *CallWatch) matches(call core.CallTrace) bool {
+	if call.Error != "" {
+		return false
+	}
+
+	switch cw.cfg.Direction {
+	case DirectionFrom:
+		if call.From != cw.addr {
+			return false
+		}
+
+	case DirectionTo:
+		if call.To != cw.addr {
+			return false
+		}
+	}
+
+	if cw.cfg.Internal && call.Depth == 0 {
+		return false
+	}
+
+	if len(cw.types) > 0 {
+		if _, found := cw.types[call.Type]; !found {
+			return false
+		}
+	}
+
+	if cw.cfg.Threshold != nil {
+		if call.Value == nil {
+			return false
+		}
+
+		value, _ := math.WeiToEther(call.Value).Float64()
+		if value < *cw.cfg.Threshold {
+			return false
+		}
+	}
+
+	if len(cw.selectors) == 0 {
+		return true
+	}
+
+	for _, sel := range cw.selectors {
+		if bytes.Equal(call.Selector, sel) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package registry_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallWatch(t *testing.T) {
+	vault := common.HexToAddress("0x420")
+	proxy := common.HexToAddress("0x421")
+
+	call := func(callType string, from, to common.Address, ether int64, depth int) core.CallTrace {
+		return core.CallTrace{
+			Type:        callType,
+			From:        from,
+			To:          to,
+			Value:       new(big.Int).Mul(big.NewInt(ether), big.NewInt(1e18)),
+			Selector:    []byte{0xa9, 0x05, 0x9c, 0xbb},
+			Depth:       depth,
+			BlockNumber: big.NewInt(10),
+		}
+	}
+
+	reverted := call("CALL", proxy, vault, 100, 1)
+	reverted.Error = "execution reverted"
+
+	threshold := 10.0
+
+	var tests = []struct {
+		name      string
+		cfg       *registry.CallWatchCfg
+		call      core.CallTrace
+		activated bool
+	}{
+		{
+			name:      "Activate on any call without filters",
+			cfg:       &registry.CallWatchCfg{},
+			call:      call("CALL", proxy, vault, 0, 0),
+			activated: true,
+		},
+		{
+			name: "No activation for a reverted call",
+			cfg:  &registry.CallWatchCfg{},
+			call: reverted,
+		},
+		{
+			name:      "Activate on a large internal transfer into the address",
+			cfg:       &registry.CallWatchCfg{Direction: registry.DirectionTo, Threshold: &threshold, Internal: true},
+			call:      call("CALL", proxy, vault, 10, 1),
+			activated: true,
+		},
+		{
+			name: "No activation for a top level transfer when only internal calls are monitored",
+			cfg:  &registry.CallWatchCfg{Internal: true},
+			call: call("CALL", proxy, vault, 10, 0),
+		},
+		{
+			name: "No activation for a small transfer",
+			cfg:  &registry.CallWatchCfg{Threshold: &threshold},
+			call: call("CALL", proxy, vault, 9, 1),
+		},
+		{
+			name:      "Activate on a delegate call into the address",
+			cfg:       &registry.CallWatchCfg{Direction: registry.DirectionTo, CallTypes: []string{"delegatecall"}},
+			call:      call("DELEGATECALL", proxy, vault, 0, 1),
+			activated: true,
+		},
+		{
+			name: "No activation for a call from the address when only calls to it are monitored",
+			cfg:  &registry.CallWatchCfg{Direction: registry.DirectionTo},
+			call: call("CALL", vault, proxy, 0, 1),
+		},
+		{
+			name: "No activation for a call to an unmonitored selector",
+			cfg:  &registry.CallWatchCfg{Selectors: []string{"approve(address,uint256)"}},
+			call: call("CALL", proxy, vault, 0, 1),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			test.cfg.Address = vault.String()
+
+			h, err := registry.NewCallWatch(test.cfg)
+			assert.NoError(t, err)
+
+			as, err := h.Assess(core.Event{Type: core.Trace, Address: vault, Value: test.call})
+			assert.NoError(t, err)
+			assert.Equal(t, test.activated, as.Activated())
+		})
+	}
+}
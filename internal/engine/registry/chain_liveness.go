@@ -0,0 +1,151 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ChainLivenessCfg ... Configuration for the chain liveness heuristic
+type ChainLivenessCfg struct {
+	// MaxHeaderDelay ... Seconds without a new header before alerting
+	MaxHeaderDelay uint64 `json:"max_header_delay"`
+	// MaxTimestampDrift ... Seconds a header's timestamp may differ from wall-clock time
+	MaxTimestampDrift uint64 `json:"max_timestamp_drift"`
+}
+
+// Unmarshal ... Converts a general config to a chain liveness heuristic config
+func (clc *ChainLivenessCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &clc)
+}
+
+// ChainLiveness ... Heuristic that alerts when a chain stops producing blocks
+// or produces blocks with timestamps that drift from wall-clock time
+type ChainLiveness struct {
+	cfg *ChainLivenessCfg
+
+	mu       sync.Mutex
+	lastSeen time.Time
+	height   uint64
+	halted   bool
+
+	heuristic.Heuristic
+}
+
+// chainHaltedMsg ... Message to be sent to the alerting system when no header was seen
+const chainHaltedMsg = `
+	_Chain Halted_
+
+	Network: %s
+	Last Block Number: %d
+	Time Since Last Block: %s
+	Max Header Delay: %s
+`
+
+// timestampDriftMsg ... Message to be sent to the alerting system when a header's
+// timestamp drifts from wall-clock time
+const timestampDriftMsg = `
+	_Block Timestamp Drift_
+
+	Network: %s
+	Block Number: %s
+	Block Timestamp: %s
+	Drift: %s
+	Max Timestamp Drift: %s
+`
+
+// NewChainLiveness ... Initializer
+func NewChainLiveness(cfg *ChainLivenessCfg) (heuristic.Heuristic, error) {
+	if cfg.MaxHeaderDelay == 0 && cfg.MaxTimestampDrift == 0 {
+		return nil, fmt.Errorf(noLivenessThresholdErr)
+	}
+
+	return &ChainLiveness{
+		cfg:      cfg,
+		lastSeen: time.Now(),
+
+		Heuristic: heuristic.New(core.BlockHeader, core.ChainLiveness),
+	}, nil
+}
+
+// Inherit ... Carries over the header arrival state of the replaced session so that
+// an ongoing halt isn't alerted on again and the delay isn't reset by the update
+func (cl *ChainLiveness) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*ChainLiveness)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cl.lastSeen = p.lastSeen
+	cl.height = p.height
+	cl.halted = p.halted
+}
+
+// Assess ... Records the arrival of a header and checks the timestamp drift of the chain tip
+func (cl *ChainLiveness) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	err := cl.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := e.Value.(types.Header)
+	if !ok {
+		return nil, fmt.Errorf(couldNotCastErr, "BlockHeader")
+	}
+
+	now := time.Now()
+
+	cl.mu.Lock()
+	// Headers below the latest seen height (e.g. reorg replacements) aren't the chain tip
+	tip := header.Number.Uint64() >= cl.height
+	if header.Number.Uint64() > cl.height {
+		cl.height = header.Number.Uint64()
+		cl.lastSeen = now
+		cl.halted = false
+	}
+	cl.mu.Unlock()
+
+	if cl.cfg.MaxTimestampDrift == 0 || !tip {
+		return heuristic.NoActivations(), nil
+	}
+
+	ts := time.Unix(int64(header.Time), 0) //#nosec G115: block timestamps fit in an int64
+	drift := now.Sub(ts).Abs()
+	maxDrift := time.Duration(cl.cfg.MaxTimestampDrift) * time.Second
+
+	if drift <= maxDrift {
+		return heuristic.NoActivations(), nil
+	}
+
+	return activation(fmt.Sprintf(timestampDriftMsg, e.Network, header.Number,
+		ts.UTC().Format(time.RFC3339), drift.Round(time.Second), maxDrift)), nil
+}
+
+// HandleTick ... Checks if a new header was seen within the max header delay.
+// A halt is only alerted on once until a new header is seen
+func (cl *ChainLiveness) HandleTick(tick core.Event) (*heuristic.ActivationSet, error) {
+	if cl.cfg.MaxHeaderDelay == 0 {
+		return heuristic.NoActivations(), nil
+	}
+
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	delay := time.Duration(cl.cfg.MaxHeaderDelay) * time.Second
+	elapsed := tick.Timestamp.Sub(cl.lastSeen)
+	if cl.halted || elapsed <= delay {
+		return heuristic.NoActivations(), nil
+	}
+
+	cl.halted = true
+	return activation(fmt.Sprintf(chainHaltedMsg, tick.Network, cl.height,
+		elapsed.Round(time.Second), delay)), nil
+}
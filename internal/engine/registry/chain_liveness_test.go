@@ -0,0 +1,83 @@
+package registry_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainLiveness(t *testing.T) {
+	h, err := registry.NewChainLiveness(&registry.ChainLivenessCfg{
+		MaxHeaderDelay:    10,
+		MaxTimestampDrift: 60,
+	})
+	assert.NoError(t, err)
+
+	th, ok := h.(heuristic.TickHandler)
+	assert.True(t, ok)
+
+	tick := func(after time.Duration) bool {
+		as, err := th.HandleTick(core.Event{
+			Type:      core.Tick,
+			Network:   core.Layer2,
+			Timestamp: time.Now().Add(after),
+		})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	header := func(num int64, drift time.Duration) bool {
+		as, err := h.Assess(core.Event{
+			Type:    core.BlockHeader,
+			Network: core.Layer2,
+			Value: types.Header{
+				Number: big.NewInt(num),
+				Time:   uint64(time.Now().Add(-drift).Unix()),
+			},
+		})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	// 1. Chain is live
+	assert.False(t, header(1, 0))
+	assert.False(t, tick(5*time.Second))
+
+	// 2. Chain halts; only alerted on once
+	assert.True(t, tick(11*time.Second))
+	assert.False(t, tick(12*time.Second))
+
+	// 3. Chain resumes and halts again
+	assert.False(t, header(2, 0))
+	assert.False(t, tick(time.Second))
+	assert.True(t, tick(20*time.Second))
+
+	// 4. Header timestamps drift from wall-clock time
+	assert.True(t, header(3, 2*time.Minute))
+	assert.True(t, header(4, -2*time.Minute))
+	assert.False(t, header(5, 30*time.Second))
+
+	// 5. Only the drift of the chain tip is checked
+	assert.False(t, header(4, 2*time.Minute))
+}
+
+func TestChainLivenessConfig(t *testing.T) {
+	_, err := registry.NewChainLiveness(&registry.ChainLivenessCfg{})
+	assert.Error(t, err, "failure should occur when no threshold is provided")
+
+	h, err := registry.NewChainLiveness(&registry.ChainLivenessCfg{MaxTimestampDrift: 60})
+	assert.NoError(t, err)
+
+	th, ok := h.(heuristic.TickHandler)
+	assert.True(t, ok)
+
+	as, err := th.HandleTick(core.Event{Type: core.Tick, Timestamp: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+	assert.False(t, as.Activated(), "no halt alert should occur without a max header delay")
+}
@@ -6,10 +6,45 @@ import (
 
 const (
 	// Error constant strings
-	invalidAddrErr  = "invalid address provided for heuristic. expected %s, got %s"
-	couldNotCastErr = "could not cast transit data value to %s type"
-	noNestedArgsErr = "no nested args found in session params"
-	zeroAddressErr  = "provided address cannot be the zero address"
+	invalidAddrErr         = "invalid address provided for heuristic. expected %s, got %s"
+	couldNotCastErr        = "could not cast transit data value to %s type"
+	noNestedArgsErr        = "no nested args found in session params"
+	zeroAddressErr         = "provided address cannot be the zero address"
+	invalidBoundErr        = "invalid %s bound provided: %s"
+	invalidRangeErr        = "lower bound %s cannot be greater than upper bound %s"
+	noBoundErr             = "no upper or lower bound provided"
+	invalidSlotErr         = "invalid storage slot provided: %v"
+	invalidABIErr          = "could not parse contract ABI: %w"
+	invalidPredicateErr    = "invalid predicate for argument %s: %s"
+	invalidValueErr        = "could not parse predicate value %s as %s"
+	noABIErr               = "predicates require a contract ABI"
+	invalidMatchErr        = "invalid predicate match mode %s"
+	invalidOutputErr       = "invalid output type %s: %w"
+	invalidCallArgsErr     = "could not decode call args: %w"
+	nonNumericOutputErr    = "bounds and deltas require a numeric output type, got %s"
+	noComparisonErr        = "no comparison provided for contract call"
+	couldNotDecodeErr      = "could not decode return value of %s: %w"
+	invalidTokenErr        = "invalid token address provided: %v"
+	noWindowErr            = "no block or time window provided"
+	noThresholdErr         = "no inflow or outflow threshold provided"
+	noLivenessThresholdErr = "no header delay or timestamp drift threshold provided"
+	noLagThresholdErr      = "no safe or finalized head lag threshold provided"
+	noSyncStatusErr        = "no sync status returned by op-node"
+	invalidCorrelationErr  = "invalid withdrawal correlation mode %s"
+	invalidDirectionErr    = "invalid transaction direction %s"
+	invalidSelectorErr     = "invalid function selector provided: %s"
+	noBlockWindowErr       = "no block window provided"
+
+	// contractABIKey ... Session param key of the ABI used by the contract event heuristic
+	contractABIKey = "abi"
+	// contractFunctionKey & contractOutputKey ... Session param keys used by the contract call heuristic
+	contractFunctionKey = "function"
+	contractOutputKey   = "output"
+	// tokenAddressKey ... Session param key of the token used by the ERC20 balance heuristic
+	tokenAddressKey = "token_address"
+
+	// selectorLen ... Byte length of a function selector
+	selectorLen = 4
 
 	// L2 bridge events
 	MessagePassed = "MessagePassed(uint256,address,address,uint256,uint256,bytes,bytes32)"
@@ -18,6 +53,7 @@ const (
 	OutputProposedEvent   = "OutputProposed(bytes32,uint256,uint256,uint256)"
 	WithdrawalProvenEvent = "WithdrawalProven(bytes32,address,address)"
 	WithdrawalFinalEvent  = "WithdrawalFinalized(bytes32,bool)"
+	TransactionDeposited  = "TransactionDeposited(address,address,uint256,bytes)"
 )
 
 var (
@@ -0,0 +1,276 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ContractCallCfg ... Configuration for the contract call heuristic
+type ContractCallCfg struct {
+	Address string `json:"address"`
+	// Function ... Signature of the view function to call (e.g. balanceOf(address))
+	Function string `json:"function"`
+	// CallArgs ... Optional hex encoded ABI encoding of the function arguments
+	CallArgs string `json:"call_args"`
+	// Output ... ABI type of the value returned by the function (e.g. uint256)
+	Output string `json:"output"`
+
+	// Equals ... Value the function must return
+	Equals *string `json:"equals"`
+	// Lower & Upper ... Inclusive integer bounds on the returned value
+	Lower *string `json:"lower"`
+	Upper *string `json:"upper"`
+	// MaxDelta ... Largest absolute change of the returned value between blocks
+	MaxDelta *string `json:"max_delta"`
+}
+
+// Unmarshal ... Converts a general config to a contract call heuristic config
+func (ccc *ContractCallCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &ccc)
+}
+
+// ContractCall ... Heuristic that calls a contract view function at every block
+// and asserts a condition on its return value
+type ContractCall struct {
+	ctx context.Context
+	cfg *ContractCallCfg
+
+	data    []byte
+	outputs abi.Arguments
+
+	lower    *big.Int
+	upper    *big.Int
+	maxDelta *big.Int
+
+	mu   sync.Mutex
+	last *callObservation
+
+	heuristic.Heuristic
+}
+
+// callObservation ... Last observed return value of a contract call
+type callObservation struct {
+	value  *big.Int
+	height *big.Int
+}
+
+// contractCallMsg ... Message to be sent to the alerting system
+const contractCallMsg = `
+	_Contract Call Invariant Violated_
+
+	Contract Address: %s
+	Function: %s
+	Return Value: %s
+	Violations: %s
+	Block Number: %s
+
+	Session UUID: %s
+`
+
+// NewContractCall ... Initializer
+func NewContractCall(ctx context.Context, cfg *ContractCallCfg) (heuristic.Heuristic, error) {
+	outType, err := abi.NewType(cfg.Output, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf(invalidOutputErr, cfg.Output, err)
+	}
+
+	callArgs, err := hexutil.Decode(withHexPrefix(cfg.CallArgs))
+	if err != nil {
+		return nil, fmt.Errorf(invalidCallArgsErr, err)
+	}
+
+	cc := &ContractCall{
+		ctx:     ctx,
+		cfg:     cfg,
+		data:    append(crypto.Keccak256([]byte(cfg.Function))[:4], callArgs...),
+		outputs: abi.Arguments{{Type: outType}},
+
+		Heuristic: heuristic.New(core.BlockHeader, core.ContractCall),
+	}
+
+	if cfg.Equals == nil && cfg.Lower == nil && cfg.Upper == nil && cfg.MaxDelta == nil {
+		return nil, fmt.Errorf(noComparisonErr)
+	}
+
+	if cc.lower, err = parseBound("lower", cfg.Lower); err != nil {
+		return nil, err
+	}
+
+	if cc.upper, err = parseBound("upper", cfg.Upper); err != nil {
+		return nil, err
+	}
+
+	if cc.maxDelta, err = parseBound("max_delta", cfg.MaxDelta); err != nil {
+		return nil, err
+	}
+
+	numeric := outType.T == abi.IntTy || outType.T == abi.UintTy
+	if !numeric && (cc.lower != nil || cc.upper != nil || cc.maxDelta != nil) {
+		return nil, fmt.Errorf(nonNumericOutputErr, cfg.Output)
+	}
+
+	if cc.lower != nil && cc.upper != nil && cc.lower.Cmp(cc.upper) > 0 {
+		return nil, fmt.Errorf(invalidRangeErr, cc.lower, cc.upper)
+	}
+
+	return cc, nil
+}
+
+// withHexPrefix ... Prepends the 0x prefix to a hex string if missing
+func withHexPrefix(s string) string {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+
+	return "0x" + s
+}
+
+// Inherit ... Carries over the last returned value of the replaced session when it
+// calls the same function so that max delta checks continue across the update
+func (cc *ContractCall) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*ContractCall)
+	if !ok || common.HexToAddress(p.cfg.Address) != common.HexToAddress(cc.cfg.Address) ||
+		!bytes.Equal(p.data, cc.data) || p.cfg.Output != cc.cfg.Output {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cc.last = p.last
+}
+
+// HandleReorg ... Discards the last returned value if its block was orphaned
+func (cc *ContractCall) HandleReorg(reorg core.ChainReorg) error {
+	if reorg.AncestorHeight == nil {
+		return nil
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if cc.last != nil && cc.last.height != nil && cc.last.height.Cmp(reorg.AncestorHeight) > 0 {
+		cc.last = nil
+	}
+
+	return nil
+}
+
+// Assess ... Calls the contract function at the block height of the header
+// and checks the returned value against the configured comparisons
+func (cc *ContractCall) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	err := cc.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := e.Value.(types.Header)
+	if !ok {
+		return nil, fmt.Errorf(couldNotCastErr, "BlockHeader")
+	}
+
+	client, err := client.FromNetwork(cc.ctx, e.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Call the function and decode its return value
+	to := common.HexToAddress(cc.cfg.Address)
+	res, err := client.CallContract(cc.ctx, ethereum.CallMsg{To: &to, Data: cc.data}, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := cc.outputs.Unpack(res)
+	if err != nil {
+		return nil, fmt.Errorf(couldNotDecodeErr, cc.cfg.Function, err)
+	}
+
+	value := values[0]
+
+	// 2. Check the value against the comparisons
+	violations, err := cc.violations(value, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(violations) == 0 {
+		return heuristic.NoActivations(), nil
+	}
+
+	msg := fmt.Sprintf(contractCallMsg, to, cc.cfg.Function, formatArg(value),
+		strings.Join(violations, "; "), header.Number, cc.ID())
+	return activation(msg), nil
+}
+
+// violations ... Returns a description of every comparison the value violates
+func (cc *ContractCall) violations(value any, height *big.Int) ([]string, error) {
+	violations := make([]string, 0)
+
+	if cc.cfg.Equals != nil {
+		eq, err := argEquals(value, *cc.cfg.Equals)
+		if err != nil {
+			return nil, err
+		}
+
+		if !eq {
+			violations = append(violations, "value does not equal "+*cc.cfg.Equals)
+		}
+	}
+
+	num, numeric := toBig(value)
+	if !numeric {
+		return violations, nil
+	}
+
+	if cc.lower != nil && num.Cmp(cc.lower) < 0 {
+		violations = append(violations, "value is below lower bound "+cc.lower.String())
+	}
+
+	if cc.upper != nil && num.Cmp(cc.upper) > 0 {
+		violations = append(violations, "value is above upper bound "+cc.upper.String())
+	}
+
+	if cc.maxDelta != nil {
+		if delta := cc.delta(num, height); delta != nil && delta.CmpAbs(cc.maxDelta) > 0 {
+			violations = append(violations,
+				fmt.Sprintf("change of %s exceeds max delta %s", delta, cc.maxDelta))
+		}
+	}
+
+	return violations, nil
+}
+
+// delta ... Records the value for a height and returns its change from the
+// previously recorded value. Nil is returned for the first or a stale height
+func (cc *ContractCall) delta(num *big.Int, height *big.Int) *big.Int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	prev := cc.last
+	if prev != nil && height != nil && prev.height != nil && height.Cmp(prev.height) <= 0 {
+		return nil
+	}
+
+	cc.last = &callObservation{value: num, height: height}
+	if prev == nil {
+		return nil
+	}
+
+	return new(big.Int).Sub(num, prev.value)
+}
@@ -0,0 +1,182 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractCall(t *testing.T) {
+	token := common.HexToAddress("0x420")
+	holder := common.HexToAddress("0x69")
+
+	str := func(s string) *string { return &s }
+	word := func(v int64) []byte { return common.BigToHash(big.NewInt(v)).Bytes() }
+
+	var tests = []struct {
+		name      string
+		cfg       *registry.ContractCallCfg
+		returns   [][]byte
+		activated []bool
+		// message ... Expected to be contained by every activation message when set
+		message string
+	}{
+		{
+			name: "Activate when the return value is out of bounds",
+			cfg: &registry.ContractCallCfg{
+				Function: "totalSupply()",
+				Output:   "uint256",
+				Lower:    str("10"),
+				Upper:    str("100"),
+			},
+			returns:   [][]byte{word(50), word(101), word(9)},
+			activated: []bool{false, true, true},
+		},
+		{
+			name: "Activate when the return value isn't equal",
+			cfg: &registry.ContractCallCfg{
+				Function: "paused()",
+				Output:   "bool",
+				Equals:   str("false"),
+			},
+			returns:   [][]byte{word(0), word(1)},
+			activated: []bool{false, true},
+		},
+		{
+			name: "Activate when the return value changes by more than the max delta",
+			cfg: &registry.ContractCallCfg{
+				Function: "balanceOf(address)",
+				CallArgs: common.Bytes2Hex(common.LeftPadBytes(holder.Bytes(), 32)),
+				Output:   "uint256",
+				MaxDelta: str("1e3"),
+			},
+			returns:   [][]byte{word(5000), word(4000), word(2999)},
+			activated: []bool{false, false, true},
+		},
+		{
+			name: "Activate when a fixed size bytes return value isn't equal",
+			cfg: &registry.ContractCallCfg{
+				Function: "version()",
+				Output:   "bytes4",
+				Equals:   str("0x12345678"),
+			},
+			returns: [][]byte{
+				common.RightPadBytes(common.FromHex("0x12345678"), 32),
+				common.RightPadBytes(common.FromHex("0xdeadbeef"), 32),
+			},
+			activated: []bool{false, true},
+			message:   "0xdeadbeef",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			test.cfg.Address = token.String()
+			h, err := registry.NewContractCall(ctx, test.cfg)
+			assert.NoError(t, err)
+
+			data := crypto.Keccak256([]byte(test.cfg.Function))[:4]
+			data = append(data, common.FromHex(test.cfg.CallArgs)...)
+
+			for j, ret := range test.returns {
+				num := big.NewInt(int64(j + 1))
+				ms.MockL1.EXPECT().CallContract(gomock.Any(), ethereum.CallMsg{To: &token, Data: data}, num).
+					Return(ret, nil).Times(1)
+
+				as, err := h.Assess(core.Event{
+					Network: core.Layer1,
+					Type:    core.BlockHeader,
+					Value:   types.Header{Number: num},
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, test.activated[j], as.Activated(), "call %d", j)
+
+				if as.Activated() {
+					assert.Contains(t, as.Entries()[0].Message, "Block Number: "+num.String())
+					assert.Contains(t, as.Entries()[0].Message, test.message)
+				}
+			}
+		})
+	}
+}
+
+func TestContractCallInvalidTopic(t *testing.T) {
+	ctx, _ := mocks.Context(context.Background(), gomock.NewController(t))
+
+	equals := "true"
+	h, err := registry.NewContractCall(ctx, &registry.ContractCallCfg{Function: "paused()", Output: "bool", Equals: &equals})
+	assert.NoError(t, err)
+
+	_, err = h.Assess(core.Event{Network: core.Layer1, Type: core.Log, Value: types.Header{Number: big.NewInt(1)}})
+	assert.Error(t, err, "failure should occur when assessing an event of another topic")
+}
+
+func TestContractCallReorg(t *testing.T) {
+	token := common.HexToAddress("0x420")
+	maxDelta := "1e3"
+
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+	h, err := registry.NewContractCall(ctx, &registry.ContractCallCfg{
+		Address:  token.String(),
+		Function: "totalSupply()",
+		Output:   "uint256",
+		MaxDelta: &maxDelta,
+	})
+	assert.NoError(t, err)
+
+	data := crypto.Keccak256([]byte("totalSupply()"))[:4]
+	assess := func(height, value int64) bool {
+		num := big.NewInt(height)
+		ms.MockL1.EXPECT().CallContract(gomock.Any(), ethereum.CallMsg{To: &token, Data: data}, num).
+			Return(common.BigToHash(big.NewInt(value)).Bytes(), nil).Times(1)
+
+		as, err := h.Assess(core.Event{Network: core.Layer1, Type: core.BlockHeader, Value: types.Header{Number: num}})
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	assert.False(t, assess(1, 5000))
+	assert.True(t, assess(2, 1000)) // Orphaned value
+
+	rh, ok := h.(heuristic.ReorgHandler)
+	assert.True(t, ok)
+	assert.NoError(t, rh.HandleReorg(core.ChainReorg{AncestorHeight: big.NewInt(1)}))
+
+	// The orphaned value is no longer used to measure the change
+	assert.False(t, assess(2, 5000))
+	assert.False(t, assess(3, 5500))
+}
+
+func TestContractCallConfig(t *testing.T) {
+	lower := "1"
+
+	_, err := registry.NewContractCall(context.Background(),
+		&registry.ContractCallCfg{Function: "paused()", Output: "bool"})
+	assert.Error(t, err, "failure should occur when no comparison is provided")
+
+	_, err = registry.NewContractCall(context.Background(),
+		&registry.ContractCallCfg{Function: "paused()", Output: "bool", Lower: &lower})
+	assert.Error(t, err, "failure should occur when bounding a non numeric output")
+
+	_, err = registry.NewContractCall(context.Background(),
+		&registry.ContractCallCfg{Function: "paused()", Output: "float", Lower: &lower})
+	assert.Error(t, err, "failure should occur when the output type is invalid")
+
+	_, err = registry.NewContractCall(context.Background(),
+		&registry.ContractCallCfg{Function: "paused()", Output: "uint256", CallArgs: "0xzz", Lower: &lower})
+	assert.Error(t, err, "failure should occur when the call args aren't hex")
+}
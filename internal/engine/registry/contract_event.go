@@ -7,6 +7,7 @@ import (
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -17,6 +18,13 @@ type EventInvConfig struct {
 	ContractName string   `json:"contract_name"`
 	Address      string   `json:"address"`
 	Sigs         []string `json:"args"`
+
+	// ABI ... Optional ABI fragment or full ABI used to decode matching logs
+	ABI json.RawMessage `json:"abi"`
+	// Predicates ... Optional conditions on decoded arguments that must hold for an activation
+	Predicates []ArgPredicate `json:"predicates"`
+	// Match ... Whether any (default) or all of the applicable predicates must hold
+	Match string `json:"match"`
 }
 
 // Unmarshal ... Converts a general config to an event heuristic config
@@ -28,6 +36,7 @@ func (eic *EventInvConfig) Unmarshal(isp *core.SessionParams) error {
 type EventHeuristic struct {
 	cfg  *EventInvConfig
 	sigs []common.Hash
+	abi  *abi.ABI
 
 	heuristic.Heuristic
 }
@@ -42,20 +51,48 @@ const eventReportMsg = `
 	Event: %s
 `
 
+// eventArgsMsg ... Decoded event arguments appended to the event report message
+const eventArgsMsg = `	Args: %s
+`
+
 // NewEventHeuristic ... Initializer
-func NewEventHeuristic(cfg *EventInvConfig) heuristic.Heuristic {
+func NewEventHeuristic(cfg *EventInvConfig) (heuristic.Heuristic, error) {
 	var sigs []common.Hash
 
 	for _, sig := range cfg.Sigs {
 		sigs = append(sigs, crypto.Keccak256Hash([]byte(sig)))
 	}
 
-	return &EventHeuristic{
+	ei := &EventHeuristic{
 		cfg:  cfg,
 		sigs: sigs,
 
 		Heuristic: heuristic.New(core.Log, core.ContractEvent),
 	}
+
+	if len(cfg.ABI) > 0 {
+		parsed, err := parseABI(cfg.ABI)
+		if err != nil {
+			return nil, err
+		}
+		ei.abi = parsed
+	}
+
+	if len(cfg.Predicates) > 0 && ei.abi == nil {
+		return nil, fmt.Errorf(noABIErr)
+	}
+
+	for i := range cfg.Predicates {
+		if err := cfg.Predicates[i].validate(ei.abi); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Match != "" && cfg.Match != MatchAny && cfg.Match != MatchAll {
+		return nil, fmt.Errorf(invalidMatchErr, cfg.Match)
+	}
+
+	return ei, nil
 }
 
 // Assess ... Checks if the balance is within the bounds
@@ -91,8 +128,69 @@ func (ei *EventHeuristic) Assess(e core.Event) (*heuristic.ActivationSet, error)
 		return heuristic.NoActivations(), nil
 	}
 
+	msg := fmt.Sprintf(eventReportMsg, ei.cfg.ContractName, log.Address, log.TxHash.Hex(), sigHit)
+
+	// 3. Decode the log and evaluate the argument predicates if an ABI is provided
+	if ei.abi != nil {
+		event, err := ei.abi.EventByID(log.Topics[0])
+		if err != nil { // Event isn't declared in the ABI
+			return activation(msg), nil
+		}
+
+		args, err := decodeLog(event, log)
+		if err != nil {
+			// Fail open so that a malformed ABI doesn't silence the heuristic
+			return activation(msg + fmt.Sprintf(eventArgsMsg, "could not decode: "+err.Error())), nil
+		}
+
+		activated, err = ei.evalPredicates(event.Name, args)
+		if err != nil {
+			return nil, err
+		}
+
+		if !activated {
+			return heuristic.NoActivations(), nil
+		}
+
+		msg += fmt.Sprintf(eventArgsMsg, formatArgs(event, args))
+	}
+
+	return activation(msg), nil
+}
+
+// evalPredicates ... Evaluates the predicates that apply to a decoded event. An event
+// that no predicate applies to is always activated
+func (ei *EventHeuristic) evalPredicates(event string, args map[string]any) (bool, error) {
+	applied := false
+
+	for i := range ei.cfg.Predicates {
+		p := &ei.cfg.Predicates[i]
+		if !p.appliesTo(event, args) {
+			continue
+		}
+		applied = true
+
+		ok, err := p.Eval(args[p.Arg])
+		if err != nil {
+			return false, err
+		}
+
+		if ok && ei.cfg.Match != MatchAll {
+			return true, nil
+		}
+
+		if !ok && ei.cfg.Match == MatchAll {
+			return false, nil
+		}
+	}
+
+	return !applied || ei.cfg.Match == MatchAll, nil
+}
+
+// activation ... Returns an activation set with a single activation
+func activation(msg string) *heuristic.ActivationSet {
 	return heuristic.NewActivationSet().Add(&heuristic.Activation{
 		TimeStamp: time.Now(),
-		Message:   fmt.Sprintf(eventReportMsg, ei.cfg.ContractName, log.Address, log.TxHash.Hex(), sigHit),
-	}), nil
+		Message:   msg,
+	})
 }
@@ -1,6 +1,9 @@
 package registry_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/base-org/pessimism/internal/core"
@@ -19,12 +22,13 @@ func Test_Event_Log_Heuristic(t *testing.T) {
 		{
 			name: "Successful Activation",
 			function: func(t *testing.T, cfg *registry.EventInvConfig) {
-				ei := registry.NewEventHeuristic(
+				ei, err := registry.NewEventHeuristic(
 					&registry.EventInvConfig{
 						Address:      "0x0000000000000000000000000000000000000420",
 						ContractName: "0x69",
 						Sigs:         []string{"0x420"},
 					})
+				assert.NoError(t, err)
 
 				hash := crypto.Keccak256Hash([]byte("0x420"))
 
@@ -46,12 +50,13 @@ func Test_Event_Log_Heuristic(t *testing.T) {
 		{
 			name: "Error Activation Due to Mismatched Addresses",
 			function: func(t *testing.T, cfg *registry.EventInvConfig) {
-				ei := registry.NewEventHeuristic(
+				ei, err := registry.NewEventHeuristic(
 					&registry.EventInvConfig{
 						Address:      "0x0000000000000000000000000000000000000420",
 						ContractName: "0x69",
 						Sigs:         []string{"0x420"},
 					})
+				assert.NoError(t, err)
 
 				hash := crypto.Keccak256Hash([]byte("0x420"))
 
@@ -72,12 +77,13 @@ func Test_Event_Log_Heuristic(t *testing.T) {
 		{
 			name: "No Activation Due to Missing Signature",
 			function: func(t *testing.T, cfg *registry.EventInvConfig) {
-				ei := registry.NewEventHeuristic(
+				ei, err := registry.NewEventHeuristic(
 					&registry.EventInvConfig{
 						Address:      "0x0000000000000000000000000000000000000420",
 						ContractName: "0x69",
 						Sigs:         []string{"0x424"},
 					})
+				assert.NoError(t, err)
 
 				hash := crypto.Keccak256Hash([]byte("0x420"))
 
@@ -104,3 +110,166 @@ func Test_Event_Log_Heuristic(t *testing.T) {
 	}
 
 }
+
+func TestEventHeuristicPredicates(t *testing.T) {
+	const transferABI = `{"anonymous":false,"inputs":[` +
+		`{"indexed":true,"name":"from","type":"address"},` +
+		`{"indexed":true,"name":"to","type":"address"},` +
+		`{"indexed":false,"name":"value","type":"uint256"}],` +
+		`"name":"Transfer","type":"event"}`
+
+	token := common.HexToAddress("0x420")
+	from := common.HexToAddress("0x1")
+	trusted := common.HexToAddress("0x2")
+	unknown := common.HexToAddress("0x3")
+
+	transfer := func(to common.Address, value *big.Int) core.Event {
+		return core.Event{
+			Type:    core.Log,
+			Address: token,
+			Value: types.Log{
+				Address: token,
+				Topics: []common.Hash{
+					crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+					common.BytesToHash(from.Bytes()),
+					common.BytesToHash(to.Bytes()),
+				},
+				Data: common.BigToHash(value).Bytes(),
+			},
+		}
+	}
+
+	whale, _ := new(big.Int).SetString("2000000000000000000000", 10)
+	small := big.NewInt(1)
+
+	// Alert on Transfer where value > 1000e18 or to ∉ allowlist
+	predicates := []registry.ArgPredicate{
+		{Event: "Transfer", Arg: "value", Op: registry.OpGt, Value: "1000e18"},
+		{Arg: "to", Op: registry.OpNotIn, Values: []string{trusted.String()}},
+	}
+
+	var tests = []struct {
+		name      string
+		match     string
+		event     core.Event
+		activated bool
+	}{
+		{
+			name:  "No activation when no predicate holds",
+			event: transfer(trusted, small),
+		},
+		{
+			name:      "Activation when the value predicate holds",
+			event:     transfer(trusted, whale),
+			activated: true,
+		},
+		{
+			name:      "Activation when the allowlist predicate holds",
+			event:     transfer(unknown, small),
+			activated: true,
+		},
+		{
+			name:  "No activation when not all predicates hold",
+			match: registry.MatchAll,
+			event: transfer(unknown, small),
+		},
+		{
+			name:      "Activation when all predicates hold",
+			match:     registry.MatchAll,
+			event:     transfer(unknown, whale),
+			activated: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ei, err := registry.NewEventHeuristic(&registry.EventInvConfig{
+				Address:    token.String(),
+				Sigs:       []string{"Transfer(address,address,uint256)"},
+				ABI:        json.RawMessage(transferABI),
+				Predicates: predicates,
+				Match:      test.match,
+			})
+			assert.NoError(t, err)
+
+			as, err := ei.Assess(test.event)
+			assert.NoError(t, err)
+			assert.Equal(t, test.activated, as.Activated())
+
+			if test.activated {
+				msg := as.Entries()[0].Message
+				assert.Contains(t, msg, "from="+from.Hex())
+				assert.Contains(t, msg, "value=")
+			}
+		})
+	}
+}
+
+func TestEventHeuristicConfig(t *testing.T) {
+	abiStr := `[{"anonymous":false,"inputs":[{"indexed":false,"name":"paused","type":"bool"}],` +
+		`"name":"Paused","type":"event"}]`
+
+	_, err := registry.NewEventHeuristic(&registry.EventInvConfig{
+		Predicates: []registry.ArgPredicate{{Arg: "paused", Op: registry.OpEq, Value: "true"}},
+	})
+	assert.Error(t, err, "failure should occur when predicates are provided without an ABI")
+
+	// ABIs can also be provided as JSON encoded strings
+	raw, _ := json.Marshal(abiStr)
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        raw,
+		Predicates: []registry.ArgPredicate{{Arg: "unknown", Op: registry.OpEq, Value: "true"}},
+	})
+	assert.Error(t, err, "failure should occur when a predicate references an unknown argument")
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        raw,
+		Predicates: []registry.ArgPredicate{{Arg: "paused", Op: "matches", Value: "true"}},
+	})
+	assert.Error(t, err, "failure should occur when a predicate uses an unknown operator")
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        raw,
+		Predicates: []registry.ArgPredicate{{Arg: "paused", Op: registry.OpEq, Value: "yes please"}},
+	})
+	assert.Error(t, err, "failure should occur when a predicate value doesn't match the argument type")
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        raw,
+		Predicates: []registry.ArgPredicate{{Arg: "paused", Op: registry.OpGt, Value: "1"}},
+	})
+	assert.Error(t, err, "failure should occur when ordering a non-numeric argument")
+
+	transferABI := json.RawMessage(`{"anonymous":false,"inputs":[` +
+		`{"indexed":true,"name":"to","type":"address"},` +
+		`{"indexed":false,"name":"value","type":"uint256"}],` +
+		`"name":"Transfer","type":"event"}`)
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        transferABI,
+		Predicates: []registry.ArgPredicate{{Arg: "value", Op: registry.OpGt, Value: "1e18x"}},
+	})
+	assert.Error(t, err, "failure should occur when a numeric predicate value can't be parsed")
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        transferABI,
+		Predicates: []registry.ArgPredicate{{Arg: "to", Op: registry.OpIn, Values: []string{"0x0000000000000000000000000000000000000002", "0xnope"}}},
+	})
+	assert.Error(t, err, "failure should occur when any set predicate value can't be parsed")
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI: transferABI,
+		Predicates: []registry.ArgPredicate{
+			{Arg: "value", Op: registry.OpGte, Value: "1000e18"},
+			{Arg: "to", Op: registry.OpNotIn, Values: []string{"0x0000000000000000000000000000000000000002"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = registry.NewEventHeuristic(&registry.EventInvConfig{
+		ABI:        raw,
+		Predicates: []registry.ArgPredicate{{Arg: "paused", Op: registry.OpEq, Value: "true"}},
+	})
+	assert.NoError(t, err)
+}
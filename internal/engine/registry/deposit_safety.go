@@ -0,0 +1,357 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/common/math"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+const (
+	MissingDeposit    = "Deposit was not included on L2 within %d blocks"
+	MismatchedDeposit = "Deposit was included on L2 with a mismatched %s"
+	LargeDeposit      = "Deposit mint is greater than the %f ETH threshold"
+
+	// defaultDepositWindow ... Default number of L2 blocks a deposit must be included within
+	defaultDepositWindow = 300
+	// defaultDepositLookback ... Default number of L2 blocks preceding the head that are
+	// scanned for deposits, since deposits can be included before they're observed on L1
+	defaultDepositLookback = 150
+	// maxDepositScan ... Max number of L2 blocks scanned for deposits upon a single tick
+	maxDepositScan = 100
+)
+
+const DepositSafetyMsg = `
+	%s
+	L1PortalAddress: %s
+
+	Session UUID: %s
+	L1 Deposit Transaction Hash: %s
+	Expected L2 Transaction Hash: %s
+	From: %s
+	To: %s
+	Mint: %s ETH
+	Value: %s ETH
+`
+
+// DepositSafetyCfg ... Configuration for the deposit safety heuristic
+type DepositSafetyCfg struct {
+	L1PortalAddress string `json:"l1_portal_address"`
+
+	// MaxL2Blocks ... Number of L2 blocks a deposit must be included within
+	MaxL2Blocks uint64 `json:"max_l2_blocks"`
+	// LookbackL2Blocks ... Number of L2 blocks up to the head that are scanned for a deposit
+	// that wasn't found by hash, catching deposits included with mismatched fields
+	LookbackL2Blocks uint64 `json:"lookback_l2_blocks"`
+	// Threshold ... Deposit mint (ETH) above which a deposit is considered unusually large
+	Threshold *float64 `json:"threshold"`
+}
+
+// Unmarshal ... Converts a general config to a deposit safety heuristic config
+func (dsc *DepositSafetyCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &dsc)
+}
+
+// pendingDeposit ... A deposit observed on L1 that has yet to be included on L2
+type pendingDeposit struct {
+	tx       *types.DepositTx
+	hash     common.Hash
+	l1Tx     common.Hash
+	deadline uint64
+
+	// l1Block & l1Height ... L1 block the deposit was made in
+	l1Block  common.Hash
+	l1Height uint64
+}
+
+// DepositSafety ... Heuristic that ensures deposits made through the OptimismPortal
+// are included on L2 as expected
+type DepositSafety struct {
+	ctx context.Context
+	cfg *DepositSafetyCfg
+
+	l1PortalFilter *bindings.OptimismPortalFilterer
+	l2Client       client.EthClient
+
+	mu sync.Mutex
+	// pending ... Deposits awaiting L2 inclusion, keyed by source hash
+	pending map[common.Hash]*pendingDeposit
+	// next ... Next L2 block height to scan for deposits
+	next uint64
+
+	heuristic.Heuristic
+}
+
+// NewDepositSafety ... Initializer
+func NewDepositSafety(ctx context.Context, cfg *DepositSafetyCfg) (heuristic.Heuristic, error) {
+	clients, err := client.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := bindings.NewOptimismPortalFilterer(common.HexToAddress(cfg.L1PortalAddress), clients.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MaxL2Blocks == 0 {
+		cfg.MaxL2Blocks = defaultDepositWindow
+	}
+
+	if cfg.LookbackL2Blocks == 0 {
+		cfg.LookbackL2Blocks = defaultDepositLookback
+	}
+
+	return &DepositSafety{
+		ctx: ctx,
+		cfg: cfg,
+
+		l1PortalFilter: filter,
+		l2Client:       clients.L2Client,
+
+		pending: make(map[common.Hash]*pendingDeposit),
+
+		Heuristic: heuristic.New(core.Log, core.DepositSafety),
+	}, nil
+}
+
+// Inherit ... Carries over the pending deposits of the replaced session when it monitors
+// the same portal. Inherited deposits keep the inclusion deadline they were observed with
+func (ds *DepositSafety) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*DepositSafety)
+	if !ok || common.HexToAddress(p.cfg.L1PortalAddress) != common.HexToAddress(ds.cfg.L1PortalAddress) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for source, pd := range p.pending {
+		ds.pending[source] = pd
+	}
+	ds.next = p.next
+}
+
+// Assess ... Derives the expected L2 deposit transaction for a TransactionDeposited
+// event and tracks it until it's included on L2
+func (ds *DepositSafety) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	logging.NoContext().Debug("Checking activation for deposit safety heuristic",
+		zap.String("data", fmt.Sprintf("%v", e)))
+
+	// 1. Validate input
+	err := ds.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	log, success := e.Value.(types.Log)
+	if !success {
+		return nil, fmt.Errorf(couldNotCastErr, "types.Log")
+	}
+
+	if _, err = ds.l1PortalFilter.ParseTransactionDeposited(log); err != nil {
+		return nil, err
+	}
+
+	// 2. Derive the expected L2 deposit transaction
+	dep, err := derive.UnmarshalDepositLogEvent(&log)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &pendingDeposit{
+		tx:       dep,
+		hash:     types.NewTx(dep).Hash(),
+		l1Tx:     log.TxHash,
+		l1Block:  log.BlockHash,
+		l1Height: log.BlockNumber,
+	}
+
+	// 3. Check if the deposit is unusually large
+	as := heuristic.NewActivationSet()
+	if ds.cfg.Threshold != nil && dep.Mint != nil {
+		mint, _ := math.WeiToEther(dep.Mint).Float64()
+		if mint > *ds.cfg.Threshold {
+			as.Add(ds.activation(fmt.Sprintf(LargeDeposit, *ds.cfg.Threshold), pd))
+		}
+	}
+
+	// 4. Check if the deposit was already included on L2. The head is fetched first so
+	// that deposits included after the receipt lookup are still found when scanning
+	head, err := ds.l2Client.HeaderByNumber(ds.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ds.l2Client.TransactionReceipt(ds.ctx, pd.hash)
+	if err == nil {
+		return as, nil
+	}
+
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	// 5. Track the deposit until it's included on L2. Blocks preceding the head are also
+	// scanned since a deposit included with mismatched fields isn't found by its hash
+	start := uint64(0)
+	if height := head.Number.Uint64() + 1; height > ds.cfg.LookbackL2Blocks {
+		start = height - ds.cfg.LookbackL2Blocks
+	}
+	pd.deadline = head.Number.Uint64() + ds.cfg.MaxL2Blocks
+
+	ds.mu.Lock()
+	if len(ds.pending) == 0 || start < ds.next {
+		ds.next = start
+	}
+	ds.pending[dep.SourceHash] = pd
+	ds.mu.Unlock()
+
+	return as, nil
+}
+
+// HandleTick ... Scans new L2 blocks for pending deposits, alerting on deposits that
+// were included with mismatched fields or weren't included within the max L2 blocks
+func (ds *DepositSafety) HandleTick(_ core.Event) (*heuristic.ActivationSet, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if len(ds.pending) == 0 {
+		return heuristic.NoActivations(), nil
+	}
+
+	head, err := ds.l2Client.HeaderByNumber(ds.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	as := heuristic.NewActivationSet()
+
+	end := head.Number.Uint64()
+	if end >= ds.next+maxDepositScan {
+		end = ds.next + maxDepositScan - 1
+	}
+
+	// 1. Scan new L2 blocks for pending deposits
+	for ; ds.next <= end; ds.next++ {
+		block, err := ds.l2Client.BlockByNumber(ds.ctx, new(big.Int).SetUint64(ds.next))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range block.Transactions() {
+			if !tx.IsDepositTx() {
+				continue
+			}
+
+			pd, exists := ds.pending[tx.SourceHash()]
+			if !exists {
+				continue
+			}
+
+			delete(ds.pending, tx.SourceHash())
+			if fields := mismatchedFields(pd.tx, tx); len(fields) > 0 {
+				as.Add(ds.activation(fmt.Sprintf(MismatchedDeposit, strings.Join(fields, ", ")), pd))
+			}
+		}
+	}
+
+	// 2. Alert on deposits that weren't included in time
+	for source, pd := range ds.pending {
+		if ds.next > pd.deadline {
+			delete(ds.pending, source)
+			as.Add(ds.activation(fmt.Sprintf(MissingDeposit, ds.cfg.MaxL2Blocks), pd))
+		}
+	}
+
+	return as, nil
+}
+
+// HandleReorg ... Discards pending deposits that were made in orphaned L1 blocks
+func (ds *DepositSafety) HandleReorg(reorg core.ChainReorg) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	for source, pd := range ds.pending {
+		if reorg.IsOrphaned(pd.l1Block) ||
+			(reorg.AncestorHeight != nil && pd.l1Height > reorg.AncestorHeight.Uint64()) {
+			delete(ds.pending, source)
+		}
+	}
+
+	return nil
+}
+
+// activation ... Constructs a deposit safety activation
+func (ds *DepositSafety) activation(msg string, pd *pendingDeposit) *heuristic.Activation {
+	to := "contract creation"
+	if pd.tx.To != nil {
+		to = pd.tx.To.String()
+	}
+
+	mint := pd.tx.Mint
+	if mint == nil {
+		mint = big.NewInt(0)
+	}
+
+	return &heuristic.Activation{
+		TimeStamp: time.Now(),
+		Message: fmt.Sprintf(DepositSafetyMsg, msg, ds.cfg.L1PortalAddress, ds.ID(), pd.l1Tx, pd.hash,
+			pd.tx.From, to, math.WeiToEther(mint).String(), math.WeiToEther(pd.tx.Value).String()),
+	}
+}
+
+// mismatchedFields ... Returns the names of the fields that differ between
+// an expected deposit and the deposit transaction included on L2
+func mismatchedFields(expected *types.DepositTx, actual *types.Transaction) []string {
+	fields := make([]string, 0)
+
+	if !bigEqual(expected.Value, actual.Value()) {
+		fields = append(fields, "value")
+	}
+
+	if !bigEqual(expected.Mint, actual.Mint()) {
+		fields = append(fields, "mint")
+	}
+
+	if (expected.To == nil) != (actual.To() == nil) ||
+		(expected.To != nil && *expected.To != *actual.To()) {
+		fields = append(fields, "to")
+	}
+
+	if !bytes.Equal(expected.Data, actual.Data()) {
+		fields = append(fields, "data")
+	}
+
+	return fields
+}
+
+// bigEqual ... Compares two optional big integers, treating nil as zero
+func bigEqual(a, b *big.Int) bool {
+	if a == nil {
+		a = big.NewInt(0)
+	}
+
+	if b == nil {
+		b = big.NewInt(0)
+	}
+
+	return a.Cmp(b) == 0
+}
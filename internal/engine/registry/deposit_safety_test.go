@@ -0,0 +1,227 @@
+package registry_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPortal = "0x00000000000000000000000000000000000000aa"
+
+// depositLog ... Returns a TransactionDeposited log and the L2 deposit transaction derived from it
+func depositLog(t *testing.T) (types.Log, *types.DepositTx) {
+	to := common.HexToAddress("0x5b")
+	log, err := derive.MarshalDepositLogEvent(common.HexToAddress(testPortal), &types.DepositTx{
+		From:  common.HexToAddress("0x5a"),
+		To:    &to,
+		Mint:  big.NewInt(params.Ether),
+		Value: big.NewInt(params.Ether),
+		Gas:   100_000,
+		Data:  []byte{0x01},
+	})
+	assert.NoError(t, err)
+
+	log.BlockHash = common.HexToHash("0x01")
+	log.TxHash = common.HexToHash("0x02")
+	log.Index = 3
+
+	dep, err := derive.UnmarshalDepositLogEvent(log)
+	assert.NoError(t, err)
+
+	return *log, dep
+}
+
+func l2Block(num int64, txs ...*types.Transaction) *types.Block {
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(num)}).WithBody(txs, nil)
+}
+
+func TestDepositSafety(t *testing.T) {
+	threshold := 0.5
+
+	var tests = []struct {
+		name     string
+		cfg      *registry.DepositSafetyCfg
+		included bool
+		blocks   func(dep *types.DepositTx) []*types.Block
+		assessed string
+		ticked   string
+	}{
+		{
+			name:     "Deposit already included on L2",
+			cfg:      &registry.DepositSafetyCfg{},
+			included: true,
+		},
+		{
+			name: "Unusually large deposit",
+			cfg: &registry.DepositSafetyCfg{
+				Threshold: &threshold,
+			},
+			included: true,
+			assessed: "Deposit mint is greater than the 0.500000 ETH threshold",
+		},
+		{
+			name: "Deposit included on L2",
+			cfg:  &registry.DepositSafetyCfg{},
+			blocks: func(dep *types.DepositTx) []*types.Block {
+				return []*types.Block{l2Block(10), l2Block(11), l2Block(12, types.NewTx(dep))}
+			},
+		},
+		{
+			name: "Deposit included on L2 with a mismatched value",
+			cfg:  &registry.DepositSafetyCfg{},
+			blocks: func(dep *types.DepositTx) []*types.Block {
+				forged := *dep
+				forged.Value = big.NewInt(1)
+				return []*types.Block{l2Block(10), l2Block(11, types.NewTx(&forged)), l2Block(12)}
+			},
+			ticked: "Deposit was included on L2 with a mismatched value",
+		},
+		{
+			name: "Deposit included on L2 with a mismatched value before it was observed",
+			cfg:  &registry.DepositSafetyCfg{},
+			blocks: func(dep *types.DepositTx) []*types.Block {
+				forged := *dep
+				forged.Mint = big.NewInt(1)
+				return []*types.Block{l2Block(10, types.NewTx(&forged))}
+			},
+			ticked: "Deposit was included on L2 with a mismatched mint",
+		},
+		{
+			name: "Deposit missing on L2",
+			cfg: &registry.DepositSafetyCfg{
+				MaxL2Blocks: 2,
+			},
+			blocks: func(_ *types.DepositTx) []*types.Block {
+				return []*types.Block{l2Block(10), l2Block(11), l2Block(12)}
+			},
+			ticked: "Deposit was not included on L2 within 2 blocks",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			// Only the head observed alongside the deposit is rescanned
+			test.cfg.L1PortalAddress = testPortal
+			test.cfg.LookbackL2Blocks = 1
+			h, err := registry.NewDepositSafety(ctx, test.cfg)
+			assert.NoError(t, err)
+
+			log, dep := depositLog(t)
+
+			// 1. Observe the deposit on L1
+			ms.MockL2.EXPECT().HeaderByNumber(gomock.Any(), gomock.Nil()).
+				Return(&types.Header{Number: big.NewInt(10)}, nil).Times(1)
+
+			if test.included {
+				ms.MockL2.EXPECT().TransactionReceipt(gomock.Any(), types.NewTx(dep).Hash()).
+					Return(&types.Receipt{}, nil).Times(1)
+			} else {
+				ms.MockL2.EXPECT().TransactionReceipt(gomock.Any(), types.NewTx(dep).Hash()).
+					Return(nil, ethereum.NotFound).Times(1)
+			}
+
+			as, err := h.Assess(core.Event{Type: core.Log, Value: log})
+			assert.NoError(t, err)
+			assertActivation(t, as, test.assessed)
+
+			// 2. Scan L2 for the deposit
+			th, ok := h.(heuristic.TickHandler)
+			assert.True(t, ok)
+
+			if test.blocks != nil {
+				blocks := test.blocks(dep)
+				ms.MockL2.EXPECT().HeaderByNumber(gomock.Any(), gomock.Nil()).
+					Return(blocks[len(blocks)-1].Header(), nil).Times(1)
+
+				for _, block := range blocks {
+					ms.MockL2.EXPECT().BlockByNumber(gomock.Any(), block.Number()).Return(block, nil).Times(1)
+				}
+			}
+
+			as, err = th.HandleTick(core.Event{Type: core.Tick, Network: core.Layer1})
+			assert.NoError(t, err)
+			assertActivation(t, as, test.ticked)
+
+			// 3. Deposits are no longer tracked once resolved
+			as, err = th.HandleTick(core.Event{Type: core.Tick, Network: core.Layer1})
+			assert.NoError(t, err)
+			assert.False(t, as.Activated())
+		})
+	}
+}
+
+func TestDepositSafetyReorg(t *testing.T) {
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+	h, err := registry.NewDepositSafety(ctx, &registry.DepositSafetyCfg{
+		L1PortalAddress:  testPortal,
+		LookbackL2Blocks: 1,
+	})
+	assert.NoError(t, err)
+
+	log, dep := depositLog(t)
+	log.BlockNumber = 5
+
+	ms.MockL2.EXPECT().HeaderByNumber(gomock.Any(), gomock.Nil()).
+		Return(&types.Header{Number: big.NewInt(10)}, nil).Times(1)
+	ms.MockL2.EXPECT().TransactionReceipt(gomock.Any(), types.NewTx(dep).Hash()).
+		Return(nil, ethereum.NotFound).Times(1)
+
+	_, err = h.Assess(core.Event{Type: core.Log, Value: log})
+	assert.NoError(t, err)
+
+	// 1. Reorgs that don't orphan the deposit's L1 block leave it pending
+	rh, ok := h.(heuristic.ReorgHandler)
+	assert.True(t, ok)
+
+	assert.NoError(t, rh.HandleReorg(core.ChainReorg{
+		Network:        core.Layer1,
+		AncestorHeight: big.NewInt(5),
+		Orphaned:       []common.Hash{common.HexToHash("0x06")},
+	}))
+
+	ms.MockL2.EXPECT().HeaderByNumber(gomock.Any(), gomock.Nil()).
+		Return(&types.Header{Number: big.NewInt(9)}, nil).Times(1)
+
+	th, ok := h.(heuristic.TickHandler)
+	assert.True(t, ok)
+
+	as, err := th.HandleTick(core.Event{Type: core.Tick, Network: core.Layer1})
+	assert.NoError(t, err)
+	assert.False(t, as.Activated())
+
+	// 2. Deposits made in orphaned L1 blocks are no longer tracked
+	assert.NoError(t, rh.HandleReorg(core.ChainReorg{
+		Network:        core.Layer1,
+		AncestorHeight: big.NewInt(4),
+		Orphaned:       []common.Hash{log.BlockHash},
+	}))
+
+	as, err = th.HandleTick(core.Event{Type: core.Tick, Network: core.Layer1})
+	assert.NoError(t, err)
+	assert.False(t, as.Activated())
+}
+
+func assertActivation(t *testing.T, as *heuristic.ActivationSet, expected string) {
+	if expected == "" {
+		assert.False(t, as.Activated())
+		return
+	}
+
+	assert.Equal(t, 1, as.Len())
+	assert.Contains(t, as.Entries()[0].Message, expected)
+}
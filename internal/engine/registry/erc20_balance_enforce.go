@@ -0,0 +1,222 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/common/math"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	balanceOfSelector = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+	decimalsSelector  = crypto.Keccak256([]byte("decimals()"))[:4]
+	symbolSelector    = crypto.Keccak256([]byte("symbol()"))[:4]
+)
+
+// ERC20BalanceCfg ... Configuration for the ERC20 balance heuristic
+type ERC20BalanceCfg struct {
+	Address    string   `json:"address"`
+	Token      string   `json:"token_address"`
+	UpperBound *float64 `json:"upper"`
+	LowerBound *float64 `json:"lower"`
+}
+
+// Unmarshal ... Converts a general config to an ERC20 balance heuristic config
+func (ebc *ERC20BalanceCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &ebc)
+}
+
+// tokenMetadata ... Immutable token fields read once from the token contract
+type tokenMetadata struct {
+	decimals uint8
+	symbol   string
+}
+
+// ERC20BalanceHeuristic ... Heuristic that enforces bounds on the token balance of an address
+type ERC20BalanceHeuristic struct {
+	ctx context.Context
+	cfg *ERC20BalanceCfg
+
+	holder common.Address
+	token  common.Address
+
+	mu   sync.Mutex
+	meta *tokenMetadata
+
+	heuristic.Heuristic
+}
+
+// erc20ReportMsg ... Message to be sent to the alerting subsystem
+const erc20ReportMsg = `
+	Current value: %s %s
+	Upper bound: %s
+	Lower bound: %s
+
+	Token Address: %s
+	Session UUID: %s
+	Session Address: %s
+`
+
+// NewERC20BalanceHeuristic ... Initializer
+func NewERC20BalanceHeuristic(ctx context.Context, cfg *ERC20BalanceCfg) (heuristic.Heuristic, error) {
+	if cfg.UpperBound == nil && cfg.LowerBound == nil {
+		return nil, fmt.Errorf(noBoundErr)
+	}
+
+	if cfg.UpperBound != nil && cfg.LowerBound != nil && *cfg.LowerBound > *cfg.UpperBound {
+		return nil, fmt.Errorf(invalidRangeErr,
+			strconv.FormatFloat(*cfg.LowerBound, 'f', -1, 64), strconv.FormatFloat(*cfg.UpperBound, 'f', -1, 64))
+	}
+
+	return &ERC20BalanceHeuristic{
+		ctx:       ctx,
+		cfg:       cfg,
+		holder:    common.HexToAddress(cfg.Address),
+		token:     common.HexToAddress(cfg.Token),
+		Heuristic: heuristic.New(core.BlockHeader, core.ERC20BalanceEnforcement),
+	}, nil
+}
+
+// call ... Calls a function of the token contract at some height
+func (ebh *ERC20BalanceHeuristic) call(c client.EthClient, data []byte, height *big.Int) ([]byte, error) {
+	return c.CallContract(ebh.ctx, ethereum.CallMsg{To: &ebh.token, Data: data}, height)
+}
+
+// metadata ... Returns the decimals and symbol of the token, reading them on first use
+func (ebh *ERC20BalanceHeuristic) metadata(c client.EthClient, height *big.Int) (*tokenMetadata, error) {
+	ebh.mu.Lock()
+	defer ebh.mu.Unlock()
+
+	if ebh.meta != nil {
+		return ebh.meta, nil
+	}
+
+	res, err := ebh.call(c, decimalsSelector, height)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := unpackSingle("uint8", res)
+	if err != nil {
+		return nil, fmt.Errorf(couldNotDecodeErr, "decimals()", err)
+	}
+
+	meta := &tokenMetadata{
+		decimals: decimals.(uint8),
+		symbol:   ebh.token.String(),
+	}
+
+	// The symbol is optional and only used for reporting
+	if res, err := ebh.call(c, symbolSelector, height); err == nil {
+		if symbol, ok := decodeSymbol(res); ok {
+			meta.symbol = symbol
+		}
+	}
+
+	ebh.meta = meta
+	return meta, nil
+}
+
+// Inherit ... Carries over the token metadata of the replaced session when it monitors the same token
+func (ebh *ERC20BalanceHeuristic) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*ERC20BalanceHeuristic)
+	if !ok || p.token != ebh.token {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ebh.meta = p.meta
+}
+
+// Assess ... Checks if the token balance is within the bounds specified in the config
+func (ebh *ERC20BalanceHeuristic) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	header, ok := e.Value.(types.Header)
+	if !ok {
+		return nil, fmt.Errorf(couldNotCastErr, "BlockHeader")
+	}
+
+	client, err := client.FromNetwork(ebh.ctx, e.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Read the token metadata and the balance of the address
+	meta, err := ebh.metadata(client, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	data := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(ebh.holder.Bytes(), 32)...)
+	res, err := ebh.call(client, data, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := unpackSingle("uint256", res)
+	if err != nil {
+		return nil, fmt.Errorf(couldNotDecodeErr, "balanceOf(address)", err)
+	}
+
+	balance := math.ToDecimal(raw.(*big.Int), meta.decimals)
+	tokenBalance, _ := balance.Float64()
+
+	// 2. Assess if the balance is outside of the bounds
+	if !outOfBounds(tokenBalance, ebh.cfg.UpperBound, ebh.cfg.LowerBound) {
+		return heuristic.NoActivations(), nil
+	}
+
+	upper, lower := formatBounds(ebh.cfg.UpperBound, ebh.cfg.LowerBound)
+	msg := fmt.Sprintf(erc20ReportMsg, balance.Text('f', -1), meta.symbol, upper, lower,
+		ebh.token, ebh.ID(), ebh.holder)
+
+	return heuristic.NewActivationSet().Add(
+		&heuristic.Activation{
+			Message:   msg,
+			TimeStamp: time.Now(),
+		}), nil
+}
+
+// unpackSingle ... Decodes a single ABI encoded return value of some type
+func unpackSingle(typ string, data []byte) (any, error) {
+	t, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := abi.Arguments{{Type: t}}.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0], nil
+}
+
+// decodeSymbol ... Decodes a token symbol that's returned either as a string
+// or as a bytes32 (e.g. MKR)
+func decodeSymbol(data []byte) (string, bool) {
+	if symbol, err := unpackSingle("string", data); err == nil {
+		return symbol.(string), true
+	}
+
+	if len(data) == common.HashLength {
+		return string(bytes.TrimRight(data, "\x00")), true
+	}
+
+	return "", false
+}
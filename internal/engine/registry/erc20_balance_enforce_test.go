@@ -0,0 +1,92 @@
+package registry_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ERC20Balance_Assess(t *testing.T) {
+	upper := float64(5)
+	lower := float64(1)
+
+	holder := common.HexToAddress("0x123")
+	token := common.HexToAddress("0x456")
+
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+	h, err := registry.NewERC20BalanceHeuristic(ctx,
+		&registry.ERC20BalanceCfg{
+			Address:    holder.String(),
+			Token:      token.String(),
+			UpperBound: &upper,
+			LowerBound: &lower,
+		})
+	assert.NoError(t, err)
+
+	stringTy, _ := abi.NewType("string", "", nil)
+	symbol, _ := abi.Arguments{{Type: stringTy}}.Pack("USDC")
+
+	selector := func(sig string) []byte { return crypto.Keccak256([]byte(sig))[:4] }
+	balanceOf := append(selector("balanceOf(address)"), common.LeftPadBytes(holder.Bytes(), 32)...)
+
+	// Token metadata is only read once
+	ms.MockL1.EXPECT().CallContract(gomock.Any(), ethereum.CallMsg{To: &token, Data: selector("decimals()")}, gomock.Any()).
+		Return(common.BigToHash(big.NewInt(6)).Bytes(), nil).Times(1)
+	ms.MockL1.EXPECT().CallContract(gomock.Any(), ethereum.CallMsg{To: &token, Data: selector("symbol()")}, gomock.Any()).
+		Return(symbol, nil).Times(1)
+
+	var tests = []struct {
+		name      string
+		balance   int64
+		activated bool
+	}{
+		{name: "No activation", balance: 3_000_000},
+		{name: "Upper bound activation", balance: 6_000_000, activated: true},
+		{name: "Lower bound activation", balance: 500_000, activated: true},
+	}
+
+	for i, test := range tests {
+		num := big.NewInt(int64(i + 1))
+		ms.MockL1.EXPECT().CallContract(gomock.Any(), ethereum.CallMsg{To: &token, Data: balanceOf}, num).
+			Return(common.BigToHash(big.NewInt(test.balance)).Bytes(), nil).Times(1)
+
+		as, err := h.Assess(core.Event{
+			Network: core.Layer1,
+			Type:    core.BlockHeader,
+			Value:   types.Header{Number: num},
+		})
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.activated, as.Activated(), test.name)
+
+		if test.activated {
+			assert.Contains(t, as.Entries()[0].Message, "USDC", test.name)
+		}
+	}
+}
+
+func Test_ERC20Balance_Config(t *testing.T) {
+	upper := float64(1)
+	lower := float64(5)
+
+	_, err := registry.NewERC20BalanceHeuristic(context.Background(), &registry.ERC20BalanceCfg{})
+	assert.Error(t, err, "failure should occur when no bound is provided")
+
+	_, err = registry.NewERC20BalanceHeuristic(context.Background(),
+		&registry.ERC20BalanceCfg{UpperBound: &upper, LowerBound: &lower})
+	assert.Error(t, err, "failure should occur when the lower bound is greater than the upper bound")
+
+	_, err = registry.NewERC20BalanceHeuristic(context.Background(), &registry.ERC20BalanceCfg{LowerBound: &lower})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,411 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PredicateOp ... Comparison operator applied to a decoded event argument
+type PredicateOp string
+
+const (
+	OpEq    PredicateOp = "eq"
+	OpNeq   PredicateOp = "neq"
+	OpGt    PredicateOp = "gt"
+	OpGte   PredicateOp = "gte"
+	OpLt    PredicateOp = "lt"
+	OpLte   PredicateOp = "lte"
+	OpIn    PredicateOp = "in"
+	OpNotIn PredicateOp = "not_in"
+)
+
+// MatchAll & MatchAny ... Determine how the predicates applying to an event are combined
+const (
+	MatchAll = "all"
+	MatchAny = "any"
+)
+
+// bigFloatPrec ... Precision used to parse scientific notation values (e.g. 1000e18)
+const bigFloatPrec = 512
+
+// ArgPredicate ... Condition on a decoded event argument. The value(s) are
+// strings that are parsed according to the argument's ABI type
+type ArgPredicate struct {
+	// Event ... Optional event name that scopes the predicate
+	Event  string      `json:"event"`
+	Arg    string      `json:"arg"`
+	Op     PredicateOp `json:"op"`
+	Value  string      `json:"value"`
+	Values []string    `json:"values"`
+}
+
+// parseABI ... Parses an ABI fragment, a full ABI or a JSON string encoding either
+func parseABI(raw json.RawMessage) (*abi.ABI, error) {
+	raw = bytes.TrimSpace(raw)
+
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		raw = bytes.TrimSpace([]byte(encoded))
+	}
+
+	if len(raw) > 0 && raw[0] == '{' { // Single fragment
+		raw = append(append([]byte("["), raw...), ']')
+	}
+
+	parsed, err := abi.JSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf(invalidABIErr, err)
+	}
+
+	return &parsed, nil
+}
+
+// decodeLog ... Decodes the indexed and non-indexed arguments of a log
+func decodeLog(event *abi.Event, log types.Log) (map[string]any, error) {
+	args := make(map[string]any, len(event.Inputs))
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	if len(log.Topics) > 0 {
+		if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+		return nil, err
+	}
+
+	return args, nil
+}
+
+// validate ... Ensures that the predicate refers to an argument of some event in the ABI
+// and that its value(s) can be parsed according to the argument's ABI type
+func (ap *ArgPredicate) validate(contract *abi.ABI) error {
+	switch ap.Op {
+	case OpEq, OpNeq, OpGt, OpGte, OpLt, OpLte:
+	case OpIn, OpNotIn:
+		if len(ap.Values) == 0 {
+			return fmt.Errorf(invalidPredicateErr, ap.Arg, "no values provided for set operator")
+		}
+	default:
+		return fmt.Errorf(invalidPredicateErr, ap.Arg, "unknown operator "+string(ap.Op))
+	}
+
+	found := false
+	for name, event := range contract.Events {
+		if ap.Event != "" && ap.Event != name {
+			continue
+		}
+
+		for _, input := range event.Inputs {
+			if input.Name != ap.Arg {
+				continue
+			}
+
+			if err := ap.validateValues(input); err != nil {
+				return err
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return fmt.Errorf(invalidPredicateErr, ap.Arg, "argument not found in ABI")
+	}
+
+	return nil
+}
+
+// validateValues ... Ensures that the predicate value(s) can be compared to the argument
+func (ap *ArgPredicate) validateValues(input abi.Argument) error {
+	values := []string{ap.Value}
+
+	switch ap.Op {
+	case OpIn, OpNotIn:
+		values = ap.Values
+
+	case OpGt, OpGte, OpLt, OpLte:
+		if input.Type.T != abi.IntTy && input.Type.T != abi.UintTy {
+			return fmt.Errorf(invalidPredicateErr, ap.Arg, "ordering requires a numeric argument")
+		}
+	}
+
+	for _, val := range values {
+		if err := checkValue(input, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkValue ... Parses a predicate value according to the argument's ABI type
+func checkValue(input abi.Argument, val string) error {
+	// Indexed dynamic arguments are only available as the hash of their value
+	if input.Indexed && isDynamic(input.Type) {
+		if b, err := hexutil.Decode(val); err != nil || len(b) > common.HashLength {
+			return fmt.Errorf(invalidValueErr, val, "bytes32")
+		}
+		return nil
+	}
+
+	switch input.Type.T {
+	case abi.IntTy, abi.UintTy:
+		_, err := parseBig(val)
+		return err
+
+	case abi.AddressTy:
+		if !common.IsHexAddress(val) {
+			return fmt.Errorf(invalidValueErr, val, "address")
+		}
+
+	case abi.BoolTy:
+		if _, err := strconv.ParseBool(val); err != nil {
+			return fmt.Errorf(invalidValueErr, val, "bool")
+		}
+
+	case abi.BytesTy:
+		if _, err := hexutil.Decode(val); err != nil {
+			return fmt.Errorf(invalidValueErr, val, "bytes")
+		}
+
+	case abi.FixedBytesTy:
+		if b, err := hexutil.Decode(val); err != nil || len(b) > input.Type.Size {
+			return fmt.Errorf(invalidValueErr, val, input.Type.String())
+		}
+	}
+
+	return nil
+}
+
+// isDynamic ... Returns true if the ABI type isn't stored in a single topic word
+func isDynamic(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	default:
+		return false
+	}
+}
+
+// appliesTo ... Returns true if the predicate is evaluated for the decoded event
+func (ap *ArgPredicate) appliesTo(event string, args map[string]any) bool {
+	if ap.Event != "" && ap.Event != event {
+		return false
+	}
+
+	_, found := args[ap.Arg]
+	return found
+}
+
+// Eval ... Evaluates the predicate against a decoded argument value
+func (ap *ArgPredicate) Eval(val any) (bool, error) {
+	switch ap.Op {
+	case OpEq:
+		return argEquals(val, ap.Value)
+
+	case OpNeq:
+		eq, err := argEquals(val, ap.Value)
+		return !eq, err
+
+	case OpIn, OpNotIn:
+		found := false
+		for _, target := range ap.Values {
+			eq, err := argEquals(val, target)
+			if err != nil {
+				return false, err
+			}
+
+			if eq {
+				found = true
+				break
+			}
+		}
+
+		return found == (ap.Op == OpIn), nil
+
+	case OpGt, OpGte, OpLt, OpLte:
+		num, ok := toBig(val)
+		if !ok {
+			return false, fmt.Errorf(invalidPredicateErr, ap.Arg, "ordering requires a numeric argument")
+		}
+
+		target, err := parseBig(ap.Value)
+		if err != nil {
+			return false, err
+		}
+
+		cmp := num.Cmp(target)
+		switch ap.Op {
+		case OpGt:
+			return cmp > 0, nil
+		case OpGte:
+			return cmp >= 0, nil
+		case OpLt:
+			return cmp < 0, nil
+		default:
+			return cmp <= 0, nil
+		}
+
+	default:
+		return false, fmt.Errorf(invalidPredicateErr, ap.Arg, "unknown operator "+string(ap.Op))
+	}
+}
+
+// argEquals ... Compares a decoded argument to a string value
+func argEquals(val any, target string) (bool, error) {
+	if num, ok := toBig(val); ok {
+		t, err := parseBig(target)
+		if err != nil {
+			return false, err
+		}
+
+		return num.Cmp(t) == 0, nil
+	}
+
+	switch v := val.(type) {
+	case common.Address:
+		if !common.IsHexAddress(target) {
+			return false, fmt.Errorf(invalidValueErr, target, "address")
+		}
+		return v == common.HexToAddress(target), nil
+
+	case common.Hash:
+		return v == common.HexToHash(target), nil
+
+	case [32]byte:
+		return common.Hash(v) == common.HexToHash(target), nil
+
+	case []byte:
+		b, err := hexutil.Decode(target)
+		if err != nil {
+			return false, fmt.Errorf(invalidValueErr, target, "bytes")
+		}
+		return bytes.Equal(v, b), nil
+
+	case bool:
+		b, err := strconv.ParseBool(target)
+		if err != nil {
+			return false, fmt.Errorf(invalidValueErr, target, "bool")
+		}
+		return v == b, nil
+
+	case string:
+		return v == target, nil
+
+	default:
+		if fixed, ok := fixedBytes(v); ok {
+			b, err := hexutil.Decode(target)
+			if err != nil {
+				return false, fmt.Errorf(invalidValueErr, target, "bytes")
+			}
+			return bytes.Equal(fixed, b), nil
+		}
+
+		return fmt.Sprint(v) == target, nil
+	}
+}
+
+// fixedBytes ... Converts a decoded bytesN argument (i.e. a [N]byte array) to a byte slice
+func fixedBytes(val any) ([]byte, bool) {
+	v := reflect.ValueOf(val)
+	if v.Kind() != reflect.Array || v.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b, true
+}
+
+// toBig ... Converts a decoded numeric argument to a big integer
+func toBig(val any) (*big.Int, bool) {
+	switch v := val.(type) {
+	case *big.Int:
+		return v, true
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint64:
+		return new(big.Int).SetUint64(v), true
+	case int8:
+		return big.NewInt(int64(v)), true
+	case int16:
+		return big.NewInt(int64(v)), true
+	case int32:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	default:
+		return nil, false
+	}
+}
+
+// parseBig ... Parses a decimal, 0x prefixed hex or scientific notation integer
+func parseBig(s string) (*big.Int, error) {
+	if v, ok := new(big.Int).SetString(s, 0); ok {
+		return v, nil
+	}
+
+	f, _, err := big.ParseFloat(s, 10, bigFloatPrec, big.ToNearestEven)
+	if err != nil || !f.IsInt() {
+		return nil, fmt.Errorf(invalidValueErr, s, "integer")
+	}
+
+	v, _ := f.Int(nil)
+	return v, nil
+}
+
+// formatArgs ... Formats decoded arguments in ABI order
+func formatArgs(event *abi.Event, args map[string]any) string {
+	parts := make([]string, 0, len(event.Inputs))
+	for _, input := range event.Inputs {
+		val, found := args[input.Name]
+		if !found {
+			continue
+		}
+
+		parts = append(parts, fmt.Sprintf("%s=%s", input.Name, formatArg(val)))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// formatArg ... Formats a decoded argument value
+func formatArg(val any) string {
+	switch v := val.(type) {
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case [32]byte:
+		return common.Hash(v).Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	default:
+		if fixed, ok := fixedBytes(v); ok {
+			return hexutil.Encode(fixed)
+		}
+
+		return fmt.Sprint(v)
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/base-org/pessimism/internal/client"
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -23,6 +26,15 @@ const (
 	GreaterThanPortal    = "Withdrawal amount is greater than the Optimism Portal balance"
 	TooSimilarToZero     = "Withdrawal message hash is too similar to zero address"
 	TooSimilarToMax      = "Withdrawal message hash is too similar to max address"
+	UnprovenFinalization = "Withdrawal was finalized on L1 without being proven"
+	UnobservedProof      = "Withdrawal was finalized on L1 without its proof being observed"
+	EarlyFinalization    = "Withdrawal was finalized before the %s challenge period elapsed"
+	UnobservedWithdraw   = "Withdrawal proven on L1 was never observed as a MessagePassed event on L2"
+	MismatchedWithdraw   = "Withdrawal proven on L1 has a different sender or target than its MessagePassed event on L2"
+
+	// maxProvenWithdrawals ... Max number of unfinalized proofs held in memory. Proofs evicted
+	// beyond this are read from the OptimismPortal once their withdrawals are finalized
+	maxProvenWithdrawals = 10_000
 )
 
 const WithdrawalSafetyMsg = `
@@ -32,6 +44,7 @@ const WithdrawalSafetyMsg = `
 	
 	Session UUID: %s
 	L1 Proving Transaction Hash: %s
+	L1 Finalization Transaction Hash: %s
 	L2 Initialization Transaction Hash: %s
 	Withdrawal Size: %s ETH
 `
@@ -44,6 +57,8 @@ type WithdrawalMeta struct {
 	From        common.Address
 	To          common.Address
 	Value       *big.Int
+	// ProvenTime ... Timestamp of the L1 block the withdrawal was proven in
+	ProvenTime uint64
 }
 
 func MetaFromProven(log types.Log, filter *bindings.OptimismPortalFilterer) (*WithdrawalMeta, error) {
@@ -71,6 +86,7 @@ func MetaFromFinalized(log types.Log, filter *bindings.OptimismPortalFilterer) (
 		FinalizedTx: log.TxHash,
 		Hash:        final.WithdrawalHash,
 		ProvenTx:    common.HexToHash("0x0"),
+		Value:       big.NewInt(0),
 	}, nil
 }
 
@@ -84,6 +100,13 @@ type WithdrawalSafetyCfg struct {
 
 	L1PortalAddress string `json:"l1_portal_address"`
 	L2ToL1Address   string `json:"l2_to_l1_address"`
+
+	// ChallengePeriod ... Seconds a proven withdrawal must wait before being finalized.
+	// Read from the L2OutputOracle when unset
+	ChallengePeriod uint64 `json:"challenge_period"`
+
+	// Correlation ... How L1 proofs are correlated with L2 initiations (i.e. indexer or local)
+	Correlation string `json:"correlation"`
 }
 
 // L1WithdrawalSafety ... Withdrawal safety heuristic implementation
@@ -95,8 +118,17 @@ type L1WithdrawalSafety struct {
 	l1Client client.EthClient
 	// NOTE - These values can be ingested from the chain config in the future
 	l1PortalFilter  *bindings.OptimismPortalFilterer
+	l1PortalCaller  *bindings.OptimismPortalCaller
 	l2ToL1MsgPasser *bindings.L2ToL1MessagePasserCaller
 
+	mu sync.Mutex
+	// proven ... Withdrawals proven on L1 that have yet to be finalized, keyed by withdrawal hash
+	proven          map[common.Hash]*WithdrawalMeta
+	challengePeriod uint64
+	// startTime ... Timestamp of the first L1 block assessed by the session. Proofs made
+	// before it couldn't have been observed, since observed proofs aren't persisted
+	startTime uint64
+
 	*L2WithdrawalSafety
 }
 
@@ -122,6 +154,11 @@ func NewL1WithdrawalSafety(ctx context.Context, cfg *WithdrawalSafetyCfg) (heuri
 		return nil, err
 	}
 
+	caller, err := bindings.NewOptimismPortalCaller(portalAddr, clients.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
 	l2ToL1MsgPasser, err := bindings.NewL2ToL1MessagePasserCaller(l2ToL1Addr, clients.L2Client)
 	if err != nil {
 		return nil, err
@@ -137,8 +174,12 @@ func NewL1WithdrawalSafety(ctx context.Context, cfg *WithdrawalSafetyCfg) (heuri
 		cfg: cfg,
 
 		l1PortalFilter:  filter,
+		l1PortalCaller:  caller,
 		l2ToL1MsgPasser: l2ToL1MsgPasser,
 
+		proven:          make(map[common.Hash]*WithdrawalMeta),
+		challengePeriod: cfg.ChallengePeriod,
+
 		ixClient: clients.IxClient,
 		l1Client: clients.L1Client,
 
@@ -146,10 +187,38 @@ func NewL1WithdrawalSafety(ctx context.Context, cfg *WithdrawalSafetyCfg) (heuri
 	}, nil
 }
 
+// Inherit ... Carries over the unfinalized proofs of the replaced session when it
+// monitors the same portal, along with its indexed withdrawals
+func (wsh *L1WithdrawalSafety) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*L1WithdrawalSafety)
+	if !ok {
+		return
+	}
+
+	wsh.L2WithdrawalSafety.Inherit(p.L2WithdrawalSafety)
+
+	if common.HexToAddress(p.cfg.L1PortalAddress) != common.HexToAddress(wsh.cfg.L1PortalAddress) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for hash, wm := range p.proven {
+		recorded := *wm
+		wsh.proven[hash] = &recorded
+	}
+
+	wsh.startTime = p.startTime
+
+	// A challenge period read from the L2OutputOracle is only reused when none is configured
+	if wsh.cfg.ChallengePeriod == 0 && p.cfg.ChallengePeriod == 0 {
+		wsh.challengePeriod = p.challengePeriod
+	}
+}
+
 // Assess ...
 func (wsh *L1WithdrawalSafety) Assess(e core.Event) (*heuristic.ActivationSet, error) {
-	// TODO - Support running from withdrawal finalized events as well
-
 	// 1. Validate input
 	logging.NoContext().Debug("Checking activation for withdrawal enforcement heuristic",
 		zap.String("data", fmt.Sprintf("%v", e)))
@@ -169,8 +238,7 @@ func (wsh *L1WithdrawalSafety) Assess(e core.Event) (*heuristic.ActivationSet, e
 	case WithdrawalFinalSig:
 		// Since the to/from fields are unknown we cannot query
 		// the indexer API
-		invs := wsh.VerifyHash(wm.Hash)
-		return wsh.Execute(invs, wm)
+		return wsh.assessFinalized(log)
 
 	case WithdrawalProvenSig:
 		wm, err = MetaFromProven(log, wsh.l1PortalFilter)
@@ -182,6 +250,20 @@ func (wsh *L1WithdrawalSafety) Assess(e core.Event) (*heuristic.ActivationSet, e
 		return nil, fmt.Errorf("invalid topic supplied")
 	}
 
+	// 2. Record the proof so that it can be correlated with a later finalization
+	header, err := wsh.l1Client.HeaderByNumber(wsh.ctx, new(big.Int).SetUint64(log.BlockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	wm.ProvenTime = header.Time
+	wsh.markStart(header.Time)
+	wsh.recordProven(wm)
+
+	if wsh.cfg.Correlation == LocalCorrelation {
+		return wsh.assessLocalProven(log, wm)
+	}
+
 	// 3. Get withdrawal metadata from OP Indexer API
 	withdrawals, err := wsh.ixClient.GetAllWithdrawalsByAddress(wm.From)
 	if err != nil {
@@ -218,9 +300,223 @@ func (wsh *L1WithdrawalSafety) Assess(e core.Event) (*heuristic.ActivationSet, e
 
 	h := common.HexToHash(corrWithdrawal.TransactionHash)
 	wm.Value = withdrawalWEI
+	wsh.updateProven(wm)
 
 	invs := wsh.GetInvariants(portalWEI, withdrawalWEI, correlated)
 	invs = append(invs, wsh.VerifyHash(h)...)
 
 	return wsh.Execute(invs, wm)
 }
+
+// assessLocalProven ... Correlates a proven withdrawal with the MessagePassed event
+// observed on L2 for the exact withdrawal hash, rather than querying the OP Indexer API
+func (wsh *L1WithdrawalSafety) assessLocalProven(log types.Log, wm *WithdrawalMeta) (*heuristic.ActivationSet, error) {
+	l2ToL1Addr := common.HexToAddress(wsh.cfg.L2ToL1Address)
+
+	record, found, err := lookupWithdrawal(wsh.ctx, l2ToL1Addr, wm.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	portalWEI, err := wsh.l1Client.BalanceAt(wsh.ctx, common.HexToAddress(wsh.cfg.L1PortalAddress),
+		new(big.Int).SetUint64(log.BlockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	correlated, err := wsh.l2ToL1MsgPasser.SentMessages(nil, wm.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	wm.Value = big.NewInt(0)
+	if found {
+		wm.Value = record.Value
+		wm.InitTx = record.InitTx
+	}
+	wsh.updateProven(wm)
+
+	// Withdrawals present in the L2ToL1MessagePasser but missing from the index were initiated
+	// while no paired layer2 session (i.e. local correlation for the same L2ToL1MessagePasser) ran
+	if !found && correlated {
+		logging.WithContext(wsh.ctx).Warn("Proven withdrawal was not indexed; ensure a paired layer2 session is running",
+			zap.String(logging.UUID, wsh.ID().ShortString()),
+			zap.String("withdrawal_hash", wm.Hash.String()))
+	}
+
+	invs := wsh.GetInvariants(portalWEI, wm.Value, correlated)
+	invs = append(invs,
+		// Ensure the proven withdrawal was initiated on L2
+		func() (bool, string) {
+			return !found && !correlated, UnobservedWithdraw
+		},
+		// Ensure the proven withdrawal matches its initiation on L2
+		func() (bool, string) {
+			return found && (record.Sender != wm.From || record.Target != wm.To), MismatchedWithdraw
+		},
+	)
+	invs = append(invs, wsh.VerifyHash(wm.Hash)...)
+
+	return wsh.Execute(invs, wm)
+}
+
+// markStart ... Records the timestamp of the first assessed L1 block and returns
+// the session's start time
+func (wsh *L1WithdrawalSafety) markStart(timestamp uint64) uint64 {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	if wsh.startTime == 0 {
+		wsh.startTime = timestamp
+	}
+
+	return wsh.startTime
+}
+
+// recordProven ... Records a copy of a proven withdrawal, evicting the oldest
+// proof once the max number of unfinalized proofs is reached
+func (wsh *L1WithdrawalSafety) recordProven(wm *WithdrawalMeta) {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	if _, exists := wsh.proven[wm.Hash]; !exists && len(wsh.proven) >= maxProvenWithdrawals {
+		var oldest *WithdrawalMeta
+		for _, p := range wsh.proven {
+			if oldest == nil || p.ProvenTime < oldest.ProvenTime {
+				oldest = p
+			}
+		}
+
+		delete(wsh.proven, oldest.Hash)
+	}
+
+	recorded := *wm
+	wsh.proven[wm.Hash] = &recorded
+}
+
+// updateProven ... Updates a recorded proof with the metadata of its L2 initiation
+func (wsh *L1WithdrawalSafety) updateProven(wm *WithdrawalMeta) {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	if p, ok := wsh.proven[wm.Hash]; ok {
+		p.Value = wm.Value
+		p.InitTx = wm.InitTx
+	}
+}
+
+// assessFinalized ... Correlates a finalized withdrawal with its proof and ensures
+// that the challenge period elapsed between the two
+func (wsh *L1WithdrawalSafety) assessFinalized(log types.Log) (*heuristic.ActivationSet, error) {
+	wm, err := MetaFromFinalized(log, wsh.l1PortalFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := wsh.l1Client.HeaderByNumber(wsh.ctx, new(big.Int).SetUint64(log.BlockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := wsh.getChallengePeriod()
+	if err != nil {
+		return nil, err
+	}
+
+	start := wsh.markStart(header.Time)
+
+	// 1. Correlate the finalization with its observed proof
+	wsh.mu.Lock()
+	proven, observed := wsh.proven[wm.Hash]
+	if observed {
+		wm.InitTx = proven.InitTx
+		wm.ProvenTx = proven.ProvenTx
+		wm.ProvenTime = proven.ProvenTime
+		wm.From = proven.From
+		wm.To = proven.To
+		if proven.Value != nil {
+			wm.Value = proven.Value
+		}
+	}
+	wsh.mu.Unlock()
+
+	// 2. Read the proof from the OptimismPortal when it wasn't observed (e.g. it was proven
+	// before the session started or evicted), since proofs persist after finalization.
+	// The finalization is still alerted on, the on-chain proof only distinguishes the
+	// message and allows the challenge period to be checked
+	if !observed {
+		onChain, err := wsh.l1PortalCaller.ProvenWithdrawals(&bind.CallOpts{Context: wsh.ctx}, wm.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		wm.ProvenTime = onChain.Timestamp.Uint64()
+	}
+
+	// Finalized withdrawals can no longer be proven so their initiations are dropped from the index
+	if wsh.cfg.Correlation == LocalCorrelation {
+		err = removeWithdrawal(wsh.ctx, common.HexToAddress(wsh.cfg.L2ToL1Address), wm.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The proof is only discarded once all fallible calls have succeeded so that
+	// a retried assessment can still correlate it
+	wsh.mu.Lock()
+	delete(wsh.proven, wm.Hash)
+	wsh.mu.Unlock()
+
+	proofExists := wm.ProvenTime != 0
+	invs := []Invariant{
+		// Ensure the finalized withdrawal was proven through the observed path
+		func() (bool, string) {
+			if !proofExists {
+				return true, UnprovenFinalization
+			}
+
+			// Proofs made before the session started are expected to be unobserved
+			return !observed && wm.ProvenTime >= start, UnobservedProof
+		},
+		// Ensure the challenge period elapsed between the proof and the finalization
+		func() (bool, string) {
+			elapsed := header.Time - wm.ProvenTime
+			return proofExists && (header.Time < wm.ProvenTime || elapsed <= period),
+				fmt.Sprintf(EarlyFinalization, time.Duration(period)*time.Second) //#nosec G115: challenge periods fit in an int64
+		},
+	}
+	invs = append(invs, wsh.VerifyHash(wm.Hash)...)
+
+	return wsh.Execute(invs, wm)
+}
+
+// getChallengePeriod ... Returns the configured challenge period, reading the
+// finalization period from the L2OutputOracle when none is configured
+func (wsh *L1WithdrawalSafety) getChallengePeriod() (uint64, error) {
+	wsh.mu.Lock()
+	defer wsh.mu.Unlock()
+
+	if wsh.challengePeriod != 0 {
+		return wsh.challengePeriod, nil
+	}
+
+	opts := &bind.CallOpts{Context: wsh.ctx}
+
+	oracleAddr, err := wsh.l1PortalCaller.L2ORACLE(opts)
+	if err != nil {
+		return 0, err
+	}
+
+	oracle, err := bindings.NewL2OutputOracleCaller(oracleAddr, wsh.l1Client)
+	if err != nil {
+		return 0, err
+	}
+
+	period, err := oracle.FINALIZATIONPERIODSECONDS(opts)
+	if err != nil {
+		return 0, err
+	}
+
+	wsh.challengePeriod = period.Uint64()
+	return wsh.challengePeriod, nil
+}
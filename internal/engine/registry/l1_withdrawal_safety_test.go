@@ -0,0 +1,303 @@
+package registry_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum-optimism/optimism/indexer/api/models"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBlockTime = 12
+
+var (
+	testSender = common.HexToAddress("0x5a")
+	testTarget = common.HexToAddress("0x5b")
+
+	// uninitiatedHash ... Withdrawal hash absent from the L2ToL1MessagePasser's sent messages
+	uninitiatedHash = common.HexToHash("0x7a3c5b2d8e6f4a1c9b7d3e5f5e1f9a7c3b8d2e6f4a1c9b7d3e5f2a8c6b4d1e9f")
+)
+
+func provenLog(hash common.Hash, block uint64) types.Log {
+	return provenLogFrom(hash, testSender, block)
+}
+
+func provenLogFrom(hash common.Hash, from common.Address, block uint64) types.Log {
+	return types.Log{
+		BlockNumber: block,
+		TxHash:      common.HexToHash("0x01"),
+		Topics: []common.Hash{
+			registry.WithdrawalProvenSig,
+			hash,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(testTarget.Bytes()),
+		},
+	}
+}
+
+func messagePassedLog(t *testing.T, hash common.Hash, value *big.Int) types.Log {
+	passerABI, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	data, err := passerABI.Events["MessagePassed"].Inputs.NonIndexed().Pack(value, big.NewInt(100_000), []byte{}, hash)
+	assert.NoError(t, err)
+
+	return types.Log{
+		TxHash: common.HexToHash("0x03"),
+		Topics: []common.Hash{
+			registry.MessagePassedSig,
+			common.BigToHash(big.NewInt(1)),
+			common.BytesToHash(testSender.Bytes()),
+			common.BytesToHash(testTarget.Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func finalizedLog(hash common.Hash, block uint64) types.Log {
+	return types.Log{
+		BlockNumber: block,
+		TxHash:      common.HexToHash("0x02"),
+		Topics:      []common.Hash{registry.WithdrawalFinalSig, hash},
+		Data:        common.LeftPadBytes([]byte{1}, 32),
+	}
+}
+
+// expectWithdrawalCalls ... Mocks the L1 calls made by the withdrawal safety heuristic. Withdrawals
+// are reported by the OptimismPortal as proven at the provided block, or unproven when zero
+func expectWithdrawalCalls(t *testing.T, ms *mocks.MockSuite, provenAt uint64) {
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	passerABI, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	provenWithdrawals := portalABI.Methods["provenWithdrawals"]
+	sentMessages := passerABI.Methods["sentMessages"]
+	proof, err := provenWithdrawals.Outputs.Pack([32]byte{},
+		new(big.Int).SetUint64(provenAt*testBlockTime), big.NewInt(0))
+	assert.NoError(t, err)
+
+	ms.MockL1.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, n *big.Int) (*types.Header, error) {
+			return &types.Header{Number: n, Time: n.Uint64() * testBlockTime}, nil
+		}).AnyTimes()
+	ms.MockL1.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil), nil).AnyTimes()
+	// Encodes to true for SentMessages, 0x..01 for L2ORACLE and 1 for FINALIZATION_PERIOD_SECONDS
+	ms.MockL1.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+			if bytes.HasPrefix(msg.Data, provenWithdrawals.ID) {
+				return proof, nil
+			}
+
+			if bytes.HasPrefix(msg.Data, sentMessages.ID) && bytes.HasSuffix(msg.Data, uninitiatedHash.Bytes()) {
+				return make([]byte, 32), nil
+			}
+
+			return common.LeftPadBytes([]byte{1}, 32), nil
+		}).AnyTimes()
+}
+
+func withdrawalCfg(challengePeriod uint64, correlation string) *registry.WithdrawalSafetyCfg {
+	return &registry.WithdrawalSafetyCfg{
+		Threshold:            0.5,
+		CoefficientThreshold: 0.5,
+		L1PortalAddress:      "0x00000000000000000000000000000000000000aa",
+		L2ToL1Address:        "0x00000000000000000000000000000000000000bb",
+		ChallengePeriod:      challengePeriod,
+		Correlation:          correlation,
+	}
+}
+
+func createL1WithdrawalSafety(t *testing.T, challengePeriod, provenAt uint64) heuristic.Heuristic {
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+	expectWithdrawalCalls(t, ms, provenAt)
+	ms.MockIndexer.EXPECT().GetAllWithdrawalsByAddress(gomock.Any()).
+		Return([]models.WithdrawalItem{{
+			TransactionHash: "0x5e1f9a7c3b8d2e6f4a1c9b7d3e5f2a8c6b4d1e9f7a3c5b2d8e6f4a1c9b7d3e5f",
+			Amount:          "1",
+		}}, nil).AnyTimes()
+
+	h, err := registry.NewL1WithdrawalSafety(ctx, withdrawalCfg(challengePeriod, registry.IndexerCorrelation))
+	assert.NoError(t, err)
+
+	return h
+}
+
+func TestL1WithdrawalSafetyFinalization(t *testing.T) {
+	hash := common.HexToHash("0x9c4e2b7a1d5f3c8e6a2b4d9f1e7c3a5b8d2f6e4a1c9b7d3e5f2a8c6b4d1e9f7a")
+
+	var tests = []struct {
+		name            string
+		challengePeriod uint64
+		proveAt         uint64
+		// chainProveAt ... Block the OptimismPortal reports the withdrawal as proven at
+		chainProveAt uint64
+		// startAt ... Block the proof of another withdrawal is observed at, starting the session
+		startAt    uint64
+		finalizeAt uint64
+		expected   string
+	}{
+		{
+			name:            "Finalization without an observed proof",
+			challengePeriod: 120,
+			finalizeAt:      100,
+			expected:        registry.UnprovenFinalization,
+		},
+		{
+			name:            "Finalization of a proof that wasn't observed",
+			challengePeriod: 120,
+			startAt:         99,
+			chainProveAt:    100,
+			finalizeAt:      111,
+			expected:        registry.UnobservedProof,
+		},
+		{
+			name:            "Finalization of a proof made before the session started",
+			challengePeriod: 120,
+			chainProveAt:    100,
+			finalizeAt:      111,
+		},
+		{
+			name:            "Early finalization of a proof that wasn't observed",
+			challengePeriod: 120,
+			startAt:         99,
+			chainProveAt:    100,
+			finalizeAt:      110,
+			expected:        "Withdrawal was finalized before the 2m0s challenge period elapsed",
+		},
+		{
+			name:            "Finalization after the challenge period",
+			challengePeriod: 120,
+			proveAt:         100,
+			finalizeAt:      111,
+		},
+		{
+			name:            "Finalization before the challenge period",
+			challengePeriod: 120,
+			proveAt:         100,
+			finalizeAt:      110,
+			expected:        "Withdrawal was finalized before the 2m0s challenge period elapsed",
+		},
+		{
+			name:       "Challenge period read from the L2OutputOracle",
+			proveAt:    100,
+			finalizeAt: 100,
+			expected:   "Withdrawal was finalized before the 1s challenge period elapsed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := createL1WithdrawalSafety(t, test.challengePeriod, test.chainProveAt)
+
+			if test.startAt != 0 {
+				as, err := h.Assess(core.Event{Type: core.Log, Value: provenLog(common.HexToHash("0x01"), test.startAt)})
+				assert.NoError(t, err)
+				assert.False(t, as.Activated())
+			}
+
+			if test.proveAt != 0 {
+				as, err := h.Assess(core.Event{Type: core.Log, Value: provenLog(hash, test.proveAt)})
+				assert.NoError(t, err)
+				assert.False(t, as.Activated())
+			}
+
+			as, err := h.Assess(core.Event{Type: core.Log, Value: finalizedLog(hash, test.finalizeAt)})
+			assert.NoError(t, err)
+
+			if test.expected == "" {
+				assert.False(t, as.Activated())
+				return
+			}
+
+			assert.True(t, as.Activated())
+			assert.Contains(t, as.Entries()[0].Message, test.expected)
+		})
+	}
+}
+
+func TestL1WithdrawalSafetyLocalCorrelation(t *testing.T) {
+	hash := common.HexToHash("0x9c4e2b7a1d5f3c8e6a2b4d9f1e7c3a5b8d2f6e4a1c9b7d3e5f2a8c6b4d1e9f7a")
+	unknown := common.HexToHash("0x3b8d2e6f4a1c9b7d3e5f2a8c6b4d1e9f7a3c5b2d8e6f4a1c9b7d3e5f5e1f9a7c")
+
+	var tests = []struct {
+		name     string
+		hash     common.Hash
+		from     common.Address
+		expected string
+	}{
+		{
+			name: "Proof matches the observed initiation",
+			hash: hash,
+			from: testSender,
+		},
+		{
+			name: "Proof was never indexed but exists in the L2ToL1MessagePasser",
+			hash: unknown,
+			from: testSender,
+		},
+		{
+			name:     "Proof was never initiated on L2",
+			hash:     uninitiatedHash,
+			from:     testSender,
+			expected: registry.UnobservedWithdraw,
+		},
+		{
+			name:     "Proof sender differs from the observed initiation",
+			hash:     hash,
+			from:     common.HexToAddress("0x5c"),
+			expected: registry.MismatchedWithdraw,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+			// The indexer is never queried when correlating locally
+			expectWithdrawalCalls(t, ms, 100)
+
+			cfg := withdrawalCfg(120, registry.LocalCorrelation)
+
+			l2, err := registry.NewL2WithdrawalSafety(ctx, cfg)
+			assert.NoError(t, err)
+
+			l1, err := registry.NewL1WithdrawalSafety(ctx, cfg)
+			assert.NoError(t, err)
+
+			// 1. Observe the withdrawal initiation on L2
+			_, err = l2.Assess(core.Event{Type: core.Log, Value: messagePassedLog(t, hash, big.NewInt(1))})
+			assert.NoError(t, err)
+
+			// 2. Correlate the L1 proof with the initiation
+			as, err := l1.Assess(core.Event{Type: core.Log, Value: provenLogFrom(test.hash, test.from, 100)})
+			assert.NoError(t, err)
+
+			if test.expected == "" {
+				assert.False(t, as.Activated())
+			} else {
+				assert.True(t, as.Activated())
+				assert.Contains(t, as.Entries()[0].Message, test.expected)
+			}
+
+			// 3. Finalized withdrawals are removed from the index
+			_, err = l1.Assess(core.Event{Type: core.Log, Value: finalizedLog(test.hash, 200)})
+			assert.NoError(t, err)
+
+			_, err = ms.SS.GetSlice(ctx, core.MakeWithdrawalKey(common.HexToAddress(cfg.L2ToL1Address), test.hash))
+			assert.Error(t, err)
+		})
+	}
+}
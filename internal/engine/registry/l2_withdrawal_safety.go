@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/base-org/pessimism/internal/common/math"
@@ -32,6 +33,10 @@ type L2WithdrawalSafety struct {
 	l2ToL1MsgPasser *bindings.L2ToL1MessagePasserCaller
 	l2ToL1Filter    *bindings.L2ToL1MessagePasserFilterer
 
+	indexMu sync.Mutex
+	// indexed ... Hashes of withdrawals indexed by the session, oldest first
+	indexed []common.Hash
+
 	heuristic.Heuristic
 }
 
@@ -77,10 +82,22 @@ func NewL2WithdrawalSafety(ctx context.Context, cfg *WithdrawalSafetyCfg) (heuri
 	}, nil
 }
 
+// Inherit ... Carries over the withdrawals indexed by the replaced session when it
+// indexes the same message passer so that they are still pruned
+func (wsh *L2WithdrawalSafety) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*L2WithdrawalSafety)
+	if !ok || common.HexToAddress(p.cfg.L2ToL1Address) != common.HexToAddress(wsh.cfg.L2ToL1Address) {
+		return
+	}
+
+	p.indexMu.Lock()
+	defer p.indexMu.Unlock()
+
+	wsh.indexed = append([]common.Hash(nil), p.indexed...)
+}
+
 // Assess ...
 func (wsh *L2WithdrawalSafety) Assess(td core.Event) (*heuristic.ActivationSet, error) {
-	// TODO - Support running from withdrawal finalized events as well
-
 	// 1. Validate input
 	logging.NoContext().Debug("Checking activation for withdrawal safety heuristic",
 		zap.String("data", fmt.Sprintf("%v", td)))
@@ -100,6 +117,18 @@ func (wsh *L2WithdrawalSafety) Assess(td core.Event) (*heuristic.ActivationSet,
 		return nil, err
 	}
 
+	// 2. Index the withdrawal so that L1 sessions can correlate its proof without an indexer
+	if wsh.cfg.Correlation == LocalCorrelation {
+		err = recordWithdrawal(wsh.ctx, common.HexToAddress(wsh.cfg.L2ToL1Address), msgPassed)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = wsh.pruneIndex(msgPassed.WithdrawalHash); err != nil {
+			return nil, err
+		}
+	}
+
 	// 4. Fetch the OptimismPortal balance at the L1 block height which the withdrawal was proven
 	portalWEI, err := wsh.l1Client.BalanceAt(context.Background(), common.HexToAddress(wsh.cfg.L1PortalAddress), nil)
 	if err != nil {
@@ -130,7 +159,7 @@ func (wsh *L2WithdrawalSafety) Assess(td core.Event) (*heuristic.ActivationSet,
 		&heuristic.Activation{
 			TimeStamp: time.Now(),
 			Message: fmt.Sprintf(WithdrawalSafetyMsg, msg, wsh.cfg.L1PortalAddress, wsh.cfg.L2ToL1Address,
-				wsh.ID(), "N/A", log.TxHash.String(), math.WeiToEther(msgPassed.Value).String()),
+				wsh.ID(), "N/A", "N/A", log.TxHash.String(), math.WeiToEther(msgPassed.Value).String()),
 		},
 	), nil
 }
@@ -217,7 +246,7 @@ func (wsh *L2WithdrawalSafety) Execute(invs []Invariant, meta *WithdrawalMeta) (
 		&heuristic.Activation{
 			TimeStamp: time.Now(),
 			Message: fmt.Sprintf(WithdrawalSafetyMsg, msg, wsh.cfg.L1PortalAddress, wsh.cfg.L2ToL1Address,
-				wsh.ID(), meta.ProvenTx.String(), meta.InitTx.String(), math.WeiToEther(meta.Value).String()),
+				wsh.ID(), meta.ProvenTx.String(), meta.FinalizedTx.String(), meta.InitTx.String(), math.WeiToEther(meta.Value).String()),
 		},
 	), nil
 }
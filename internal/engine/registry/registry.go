@@ -2,7 +2,9 @@ package registry
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
@@ -32,7 +34,7 @@ func NewHeuristicTable() HeuristicTable {
 			Constructor:     constructBalanceEnforcement,
 		},
 		core.ContractEvent: {
-			PrepareValidate: ValidateTracking,
+			PrepareValidate: ContractEventPrepare,
 			Policy:          core.BothNetworks,
 			InputType:       core.Log,
 			Constructor:     constructEventInv,
@@ -49,6 +51,66 @@ func NewHeuristicTable() HeuristicTable {
 			InputType:       core.Log,
 			Constructor:     constructWithdrawalSafety,
 		},
+		core.StorageWatch: {
+			PrepareValidate: StorageWatchPrepare,
+			Policy:          core.BothNetworks,
+			InputType:       core.StorageSlot,
+			Constructor:     constructStorageWatch,
+		},
+		core.ContractCall: {
+			PrepareValidate: ContractCallPrepare,
+			Policy:          core.BothNetworks,
+			InputType:       core.BlockHeader,
+			Constructor:     constructContractCall,
+		},
+		core.ERC20BalanceEnforcement: {
+			PrepareValidate: ERC20BalancePrepare,
+			Policy:          core.BothNetworks,
+			InputType:       core.BlockHeader,
+			Constructor:     constructERC20BalanceEnforcement,
+		},
+		core.BalanceDelta: {
+			PrepareValidate: ValidateAddressing,
+			Policy:          core.BothNetworks,
+			InputType:       core.BlockHeader,
+			Constructor:     constructBalanceDelta,
+		},
+		core.ChainLiveness: {
+			PrepareValidate: ValidateNoTopicsExist,
+			Policy:          core.BothNetworks,
+			InputType:       core.BlockHeader,
+			Constructor:     constructChainLiveness,
+		},
+		core.SafeHeadLag: {
+			PrepareValidate: ValidateNoTopicsExist,
+			Policy:          core.OnlyLayer2,
+			InputType:       core.BlockHeader,
+			Constructor:     constructSafeHeadLag,
+		},
+		core.DepositSafety: {
+			PrepareValidate: DepositSafetyPrepare,
+			Policy:          core.OnlyLayer1,
+			InputType:       core.Log,
+			Constructor:     constructDepositSafety,
+		},
+		core.TransactionWatch: {
+			PrepareValidate: ValidateAddressing,
+			Policy:          core.BothNetworks,
+			InputType:       core.Transaction,
+			Constructor:     constructTransactionWatch,
+		},
+		core.RevertRate: {
+			PrepareValidate: ValidateAddressing,
+			Policy:          core.BothNetworks,
+			InputType:       core.Receipt,
+			Constructor:     constructRevertRate,
+		},
+		core.CallWatch: {
+			PrepareValidate: ValidateAddressing,
+			Policy:          core.BothNetworks,
+			InputType:       core.Trace,
+			Constructor:     constructCallWatch,
+		},
 	}
 
 	return tbl
@@ -63,7 +125,7 @@ func constructEventInv(_ context.Context, isp *core.SessionParams) (heuristic.He
 		return nil, err
 	}
 
-	return NewEventHeuristic(cfg), nil
+	return NewEventHeuristic(cfg)
 }
 
 // constructBalanceEnforcement ... Constructs a balance heuristic instance
@@ -78,6 +140,126 @@ func constructBalanceEnforcement(ctx context.Context, isp *core.SessionParams) (
 	return NewBalanceHeuristic(ctx, cfg)
 }
 
+// constructStorageWatch ... Constructs a storage watch heuristic instance
+func constructStorageWatch(_ context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &StorageWatchCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStorageWatch(cfg)
+}
+
+// constructContractCall ... Constructs a contract call heuristic instance
+func constructContractCall(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &ContractCallCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContractCall(ctx, cfg)
+}
+
+// constructERC20BalanceEnforcement ... Constructs an ERC20 balance heuristic instance
+func constructERC20BalanceEnforcement(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &ERC20BalanceCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewERC20BalanceHeuristic(ctx, cfg)
+}
+
+// constructBalanceDelta ... Constructs a balance delta heuristic instance
+func constructBalanceDelta(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &BalanceDeltaCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBalanceDeltaHeuristic(ctx, cfg)
+}
+
+// constructChainLiveness ... Constructs a chain liveness heuristic instance
+func constructChainLiveness(_ context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &ChainLivenessCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChainLiveness(cfg)
+}
+
+// constructSafeHeadLag ... Constructs a safe head lag heuristic instance
+func constructSafeHeadLag(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &SafeHeadLagCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSafeHeadLag(ctx, cfg)
+}
+
+// constructDepositSafety ... Constructs a deposit safety heuristic instance
+func constructDepositSafety(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &DepositSafetyCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDepositSafety(ctx, cfg)
+}
+
+// constructTransactionWatch ... Constructs a transaction watch heuristic instance
+func constructTransactionWatch(_ context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &TxWatchCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTransactionWatch(cfg)
+}
+
+// constructRevertRate ... Constructs a revert rate heuristic instance
+func constructRevertRate(_ context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &RevertRateCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRevertRate(cfg)
+}
+
+// constructCallWatch ... Constructs a call watch heuristic instance
+func constructCallWatch(_ context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
+	cfg := &CallWatchCfg{}
+
+	err := cfg.Unmarshal(isp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCallWatch(cfg)
+}
+
 // constructFaultDetector ... Constructs a fault detector heuristic instance
 func constructFaultDetector(ctx context.Context, isp *core.SessionParams) (heuristic.Heuristic, error) {
 	cfg := &FaultDetectorCfg{}
@@ -108,6 +290,16 @@ func constructWithdrawalSafety(ctx context.Context, isp *core.SessionParams) (he
 		return nil, fmt.Errorf("invalid coefficient threshold supplied for withdrawal safety heuristic")
 	}
 
+	switch cfg.Correlation {
+	case "":
+		cfg.Correlation = IndexerCorrelation
+
+	case IndexerCorrelation, LocalCorrelation:
+
+	default:
+		return nil, fmt.Errorf(invalidCorrelationErr, cfg.Correlation)
+	}
+
 	switch isp.Net {
 	case core.Layer1:
 		return NewL1WithdrawalSafety(ctx, cfg)
@@ -180,7 +372,7 @@ func WithdrawHeuristicPrep(cfg *core.SessionParams) error {
 	case core.Layer1:
 		cfg.SetValue(logging.AddrKey, l1Portal)
 		cfg.SetNestedArg(WithdrawalProvenEvent)
-		// cfg.SetNestedArg(WithdrawalFinalEvent)
+		cfg.SetNestedArg(WithdrawalFinalEvent)
 	case core.Layer2:
 		cfg.SetValue(logging.AddrKey, l2MsgPasser)
 		cfg.SetNestedArg(MessagePassed)
@@ -189,6 +381,24 @@ func WithdrawHeuristicPrep(cfg *core.SessionParams) error {
 	return nil
 }
 
+// DepositSafetyPrepare ... Configures the session params with the OptimismPortal
+// address key and nested args for the ETL to subscribe to TransactionDeposited events
+func DepositSafetyPrepare(cfg *core.SessionParams) error {
+	l1Portal, err := cfg.Value(core.L1Portal)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateNoTopicsExist(cfg)
+	if err != nil {
+		return err
+	}
+
+	cfg.SetValue(logging.AddrKey, l1Portal)
+	cfg.SetNestedArg(TransactionDeposited)
+	return nil
+}
+
 // FaultDetectionPrepare ... Configures the session params with the appropriate
 // address key and nested args for the ETL to subscribe to L2OutputOracle events
 func FaultDetectionPrepare(cfg *core.SessionParams) error {
@@ -212,3 +422,94 @@ func FaultDetectionPrepare(cfg *core.SessionParams) error {
 	cfg.SetNestedArg(OutputProposedEvent)
 	return nil
 }
+
+// StorageWatchPrepare ... Ensures that an address and storage slots exist in the session
+// params and normalizes the slots to 32 byte words so that sessions watching the same
+// slot share a single nested state entry
+func StorageWatchPrepare(cfg *core.SessionParams) error {
+	err := ValidateTracking(cfg)
+	if err != nil {
+		return err
+	}
+
+	slots := make([]any, 0, len(cfg.NestedArgs()))
+	for _, arg := range cfg.NestedArgs() {
+		slot, success := arg.(string)
+		if !success {
+			return fmt.Errorf(invalidSlotErr, arg)
+		}
+
+		slots = append(slots, common.HexToHash(slot).Hex())
+	}
+
+	cfg.SetValue(core.NestedArgs, slots)
+	return nil
+}
+
+// ContractEventPrepare ... Ensures that an address and event signatures exist in the
+// session params. When an ABI is provided without any event signatures, every
+// event declared in the ABI is monitored
+func ContractEventPrepare(cfg *core.SessionParams) error {
+	if raw, err := cfg.Value(contractABIKey); err == nil && len(cfg.NestedArgs()) == 0 {
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+
+		contract, err := parseABI(encoded)
+		if err != nil {
+			return err
+		}
+
+		sigs := make([]string, 0, len(contract.Events))
+		for _, event := range contract.Events {
+			if !event.Anonymous {
+				sigs = append(sigs, event.Sig)
+			}
+		}
+
+		sort.Strings(sigs)
+		for _, sig := range sigs {
+			cfg.SetNestedArg(sig)
+		}
+	}
+
+	return ValidateTracking(cfg)
+}
+
+// ContractCallPrepare ... Ensures that a contract address, function signature
+// and output type exist in the session params
+func ContractCallPrepare(cfg *core.SessionParams) error {
+	err := ValidateAddressing(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range []string{contractFunctionKey, contractOutputKey} {
+		if _, err := cfg.Value(key); err != nil {
+			return err
+		}
+	}
+
+	return ValidateNoTopicsExist(cfg)
+}
+
+// ERC20BalancePrepare ... Ensures that a holder and token address exist in the session params
+func ERC20BalancePrepare(cfg *core.SessionParams) error {
+	err := ValidateAddressing(cfg)
+	if err != nil {
+		return err
+	}
+
+	token, err := cfg.Value(tokenAddressKey)
+	if err != nil {
+		return err
+	}
+
+	tokenStr, success := token.(string)
+	if !success || !common.IsHexAddress(tokenStr) {
+		return fmt.Errorf(invalidTokenErr, token)
+	}
+
+	return nil
+}
@@ -47,6 +47,7 @@ func TestUnsafeWithdrawPrepare(t *testing.T) {
 	isp.SetValue(core.L2ToL1MessagePasser, "0x666")
 	err = registry.WithdrawHeuristicPrep(isp)
 	assert.NoError(t, err)
+	assert.Equal(t, []any{registry.WithdrawalProvenEvent, registry.WithdrawalFinalEvent}, isp.NestedArgs())
 
 	isp.SetNestedArg("transfer(address,address,uint256)")
 	err = registry.WithdrawHeuristicPrep(isp)
@@ -54,6 +55,21 @@ func TestUnsafeWithdrawPrepare(t *testing.T) {
 
 }
 
+func TestDepositSafetyPrepare(t *testing.T) {
+	isp := core.NewSessionParams(core.Layer1)
+
+	err := registry.DepositSafetyPrepare(isp)
+	assert.Error(t, err, "failure should occur when no l1_portal is provided")
+
+	isp.SetValue(core.L1Portal, "0x69")
+	err = registry.DepositSafetyPrepare(isp)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{registry.TransactionDeposited}, isp.NestedArgs())
+
+	err = registry.DepositSafetyPrepare(isp)
+	assert.Error(t, err, "failure should occur when nested args are provided")
+}
+
 func Test_InvTable(t *testing.T) {
 	tabl := registry.NewHeuristicTable()
 
@@ -66,3 +82,63 @@ func Test_InvTable(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageWatchPrepare(t *testing.T) {
+	isp := core.NewSessionParams(core.Layer1)
+	err := registry.StorageWatchPrepare(isp)
+	assert.Error(t, err, "failure should occur when no address is provided")
+
+	isp.SetValue(logging.AddrKey, "0x69")
+	err = registry.StorageWatchPrepare(isp)
+	assert.Error(t, err, "failure should occur when no slot is provided")
+
+	isp.SetNestedArg("0x1")
+	err = registry.StorageWatchPrepare(isp)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"0x0000000000000000000000000000000000000000000000000000000000000001"}, isp.NestedArgs())
+}
+
+func TestContractEventPrepare(t *testing.T) {
+	isp := core.NewSessionParams(core.Layer1)
+	isp.SetValue(logging.AddrKey, "0x69")
+	isp.SetValue("abi", []any{
+		map[string]any{
+			"name": "Paused", "type": "event",
+			"inputs": []any{map[string]any{"name": "account", "type": "address"}},
+		},
+		map[string]any{"name": "Unpaused", "type": "event", "inputs": []any{}},
+	})
+
+	err := registry.ContractEventPrepare(isp)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"Paused(address)", "Unpaused()"}, isp.NestedArgs())
+}
+
+func TestContractCallPrepare(t *testing.T) {
+	isp := core.NewSessionParams(core.Layer1)
+	isp.SetValue(logging.AddrKey, "0x69")
+
+	err := registry.ContractCallPrepare(isp)
+	assert.Error(t, err, "failure should occur when no function is provided")
+
+	isp.SetValue("function", "totalSupply()")
+	isp.SetValue("output", "uint256")
+	err = registry.ContractCallPrepare(isp)
+	assert.NoError(t, err)
+}
+
+func TestERC20BalancePrepare(t *testing.T) {
+	isp := core.NewSessionParams(core.Layer1)
+	isp.SetValue(logging.AddrKey, "0x69")
+
+	err := registry.ERC20BalancePrepare(isp)
+	assert.Error(t, err, "failure should occur when no token address is provided")
+
+	isp.SetValue("token_address", "0x420")
+	err = registry.ERC20BalancePrepare(isp)
+	assert.Error(t, err, "failure should occur when the token address is invalid")
+
+	isp.SetValue("token_address", "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	err = registry.ERC20BalancePrepare(isp)
+	assert.NoError(t, err)
+}
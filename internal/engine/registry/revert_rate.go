@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RevertRateCfg ... Configuration for the revert rate heuristic
+type RevertRateCfg struct {
+	Address string `json:"address"`
+	// MaxReverts ... Number of reverted transactions tolerated within the window
+	MaxReverts uint64 `json:"max_reverts"`
+	// WindowBlocks ... Number of blocks reverted transactions are counted over
+	WindowBlocks uint64 `json:"window_blocks"`
+}
+
+// Unmarshal ... Converts a general config to a revert rate heuristic config
+func (rrc *RevertRateCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &rrc)
+}
+
+// revertRateMsg ... Message to be sent to the alerting system when too many transactions revert
+const revertRateMsg = `
+	_Reverted Transactions_
+
+	Address: %s
+	Reverted Transactions: %d within %d blocks
+	Latest Reverted Transaction: %s
+	Gas Used: %d
+	Effective Gas Price: %s wei
+	Block Number: %d
+`
+
+// revertObservation ... Reverted transaction counted by the heuristic
+type revertObservation struct {
+	height uint64
+	block  common.Hash
+}
+
+// RevertRate ... Heuristic that alerts when more than some number of transactions
+// sent from or to an address revert within a window of blocks
+type RevertRate struct {
+	cfg  *RevertRateCfg
+	addr common.Address
+
+	mu      sync.Mutex
+	reverts []revertObservation
+
+	heuristic.Heuristic
+}
+
+// NewRevertRate ... Initializer
+func NewRevertRate(cfg *RevertRateCfg) (heuristic.Heuristic, error) {
+	if cfg.WindowBlocks == 0 {
+		return nil, fmt.Errorf(noBlockWindowErr)
+	}
+
+	return &RevertRate{
+		cfg:     cfg,
+		addr:    common.HexToAddress(cfg.Address),
+		reverts: make([]revertObservation, 0),
+
+		Heuristic: heuristic.New(core.Receipt, core.RevertRate),
+	}, nil
+}
+
+// Inherit ... Carries over the reverts counted by the replaced session when it
+// monitors the same address. Reverts outside of an updated window are pruned on the next receipt
+func (rr *RevertRate) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*RevertRate)
+	if !ok || p.addr != rr.addr {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	rr.reverts = append([]revertObservation(nil), p.reverts...)
+}
+
+// Assess ... Counts reverted transactions within the window and alerts
+// once the number of reverts exceeds the max
+func (rr *RevertRate) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	// 1. Validate and extract the receipt from the event
+	err := rr.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Address != rr.addr {
+		return nil, fmt.Errorf(invalidAddrErr, rr.cfg.Address, e.Address.String())
+	}
+
+	receipt, success := e.Value.(core.TxReceipt)
+	if !success {
+		return nil, fmt.Errorf(couldNotCastErr, "TxReceipt")
+	}
+
+	if !receipt.Reverted() {
+		return heuristic.NoActivations(), nil
+	}
+
+	height := receipt.Tx.BlockNumber
+	if height == nil {
+		height = receipt.BlockNumber
+	}
+
+	// 2. Drop reverts that fell out of the window and count the new revert
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	current := height.Uint64()
+	remaining := make([]revertObservation, 0, len(rr.reverts)+1)
+	for _, obs := range rr.reverts {
+		if obs.height+rr.cfg.WindowBlocks > current {
+			remaining = append(remaining, obs)
+		}
+	}
+
+	rr.reverts = append(remaining, revertObservation{height: current, block: e.BlockHash})
+
+	// 3. Only alert once upon exceeding the max so that a sustained
+	// breach doesn't alert on every subsequent revert
+	if uint64(len(rr.reverts)) != rr.cfg.MaxReverts+1 {
+		return heuristic.NoActivations(), nil
+	}
+
+	return heuristic.NewActivationSet().Add(&heuristic.Activation{
+		TimeStamp: time.Now(),
+		Message: fmt.Sprintf(revertRateMsg, rr.cfg.Address, len(rr.reverts), rr.cfg.WindowBlocks,
+			receipt.TxHash, receipt.GasUsed, receipt.EffectiveGasPrice, current),
+	}), nil
+}
+
+// HandleReorg ... Discards reverts counted from orphaned blocks
+func (rr *RevertRate) HandleReorg(reorg core.ChainReorg) error {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	remaining := make([]revertObservation, 0, len(rr.reverts))
+	for _, obs := range rr.reverts {
+		if !reorg.IsOrphaned(obs.block) {
+			remaining = append(remaining, obs)
+		}
+	}
+
+	rr.reverts = remaining
+	return nil
+}
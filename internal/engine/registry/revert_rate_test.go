@@ -0,0 +1,81 @@
+package registry_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevertRate(t *testing.T) {
+	bridge := common.HexToAddress("0x420")
+
+	receipt := func(status uint64, height int64) core.Event {
+		block := common.BigToHash(big.NewInt(height))
+
+		return core.Event{
+			Type:      core.Receipt,
+			Address:   bridge,
+			BlockHash: block,
+			Value: core.TxReceipt{
+				Receipt: &types.Receipt{
+					Status:            status,
+					GasUsed:           21_000,
+					EffectiveGasPrice: big.NewInt(1),
+				},
+				Tx: core.Tx{
+					Transaction: types.NewTx(&types.LegacyTx{To: &bridge}),
+					BlockHash:   block,
+					BlockNumber: big.NewInt(height),
+				},
+			},
+		}
+	}
+
+	h, err := registry.NewRevertRate(&registry.RevertRateCfg{
+		Address:      bridge.String(),
+		MaxReverts:   2,
+		WindowBlocks: 10,
+	})
+	assert.NoError(t, err)
+
+	assess := func(e core.Event) bool {
+		as, err := h.Assess(e)
+		assert.NoError(t, err)
+		return as.Activated()
+	}
+
+	// 1. Successful transactions aren't counted
+	assert.False(t, assess(receipt(types.ReceiptStatusSuccessful, 1)))
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 1)))
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 5)))
+	assert.False(t, assess(receipt(types.ReceiptStatusSuccessful, 6)))
+
+	// 2. Alert once the max number of reverts is exceeded within the window
+	assert.True(t, assess(receipt(types.ReceiptStatusFailed, 10)))
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 10)), "sustained breach shouldn't re-alert")
+
+	// 3. Reverts that fell out of the window are no longer counted
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 20)))
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 21)))
+
+	// 4. Reverts from orphaned blocks are no longer counted
+	rh, ok := h.(heuristic.ReorgHandler)
+	assert.True(t, ok)
+	assert.NoError(t, rh.HandleReorg(core.ChainReorg{
+		Orphaned: []common.Hash{common.BigToHash(big.NewInt(21))},
+	}))
+
+	assert.False(t, assess(receipt(types.ReceiptStatusFailed, 22)))
+	assert.True(t, assess(receipt(types.ReceiptStatusFailed, 23)))
+}
+
+func TestRevertRateConfig(t *testing.T) {
+	_, err := registry.NewRevertRate(&registry.RevertRateCfg{MaxReverts: 1})
+	assert.Error(t, err, "failure should occur when no window is provided")
+}
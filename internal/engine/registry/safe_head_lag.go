@@ -0,0 +1,160 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SafeHeadLagCfg ... Configuration for the safe head lag heuristic
+type SafeHeadLagCfg struct {
+	// MaxSafeLag ... Max number of blocks the safe head can fall behind the unsafe head
+	MaxSafeLag uint64 `json:"max_safe_lag"`
+	// MaxFinalizedLag ... Max number of blocks the finalized head can fall behind the unsafe head
+	MaxFinalizedLag uint64 `json:"max_finalized_lag"`
+}
+
+// Unmarshal ... Converts a general config to a safe head lag heuristic config
+func (shc *SafeHeadLagCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &shc)
+}
+
+// SafeHeadLag ... Heuristic that alerts when the L2 safe or finalized heads fall
+// too far behind the unsafe head (i.e. batches are no longer landing on L1)
+type SafeHeadLag struct {
+	ctx    context.Context
+	cfg    *SafeHeadLagCfg
+	opNode client.OpNodeClient
+
+	mu              sync.Mutex
+	safeLagged      bool
+	finalizedLagged bool
+
+	heuristic.Heuristic
+}
+
+// headLagMsg ... Message to be sent to the alerting system when a head lags the unsafe head
+const headLagMsg = `
+	_L2 %s Head Lag_
+
+	Unsafe Head: %d
+	Safe Head: %d
+	Finalized Head: %d
+	Lag: %d blocks
+	Max Lag: %d blocks
+`
+
+// NewSafeHeadLag ... Initializer
+func NewSafeHeadLag(ctx context.Context, cfg *SafeHeadLagCfg) (heuristic.Heuristic, error) {
+	if cfg.MaxSafeLag == 0 && cfg.MaxFinalizedLag == 0 {
+		return nil, fmt.Errorf(noLagThresholdErr)
+	}
+
+	opNode, err := client.OpNodeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SafeHeadLag{
+		ctx:    ctx,
+		cfg:    cfg,
+		opNode: opNode,
+
+		Heuristic: heuristic.New(core.BlockHeader, core.SafeHeadLag),
+	}, nil
+}
+
+// Inherit ... Carries over the lag state of the replaced session so that an ongoing
+// lag isn't alerted on again after the update
+func (shl *SafeHeadLag) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*SafeHeadLag)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	shl.safeLagged = p.safeLagged
+	shl.finalizedLagged = p.finalizedLagged
+}
+
+// Assess ... Queries the op-node sync status upon each new L2 header and checks
+// the safe & finalized head lags. A lag is only alerted on once until it recovers
+func (shl *SafeHeadLag) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	err := shl.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.Value.(types.Header); !ok {
+		return nil, fmt.Errorf(couldNotCastErr, "BlockHeader")
+	}
+
+	status, err := shl.opNode.SyncStatus(shl.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == nil {
+		return nil, fmt.Errorf(noSyncStatusErr)
+	}
+
+	shl.mu.Lock()
+	defer shl.mu.Unlock()
+
+	as := heuristic.NewActivationSet()
+
+	safeLag := headLag(status.UnsafeL2, status.SafeL2)
+	if shl.exceeds(safeLag, shl.cfg.MaxSafeLag, &shl.safeLagged) {
+		as.Add(&heuristic.Activation{
+			TimeStamp: time.Now(),
+			Message: fmt.Sprintf(headLagMsg, "Safe", status.UnsafeL2.Number, status.SafeL2.Number,
+				status.FinalizedL2.Number, safeLag, shl.cfg.MaxSafeLag),
+		})
+	}
+
+	finalizedLag := headLag(status.UnsafeL2, status.FinalizedL2)
+	if shl.exceeds(finalizedLag, shl.cfg.MaxFinalizedLag, &shl.finalizedLagged) {
+		as.Add(&heuristic.Activation{
+			TimeStamp: time.Now(),
+			Message: fmt.Sprintf(headLagMsg, "Finalized", status.UnsafeL2.Number, status.SafeL2.Number,
+				status.FinalizedL2.Number, finalizedLag, shl.cfg.MaxFinalizedLag),
+		})
+	}
+
+	return as, nil
+}
+
+// exceeds ... Returns true if a lag newly exceeds its max, updating the lagged flag.
+// A zero max disables the check
+func (shl *SafeHeadLag) exceeds(lag, maxLag uint64, lagged *bool) bool {
+	if maxLag == 0 || lag <= maxLag {
+		*lagged = false
+		return false
+	}
+
+	if *lagged {
+		return false
+	}
+
+	*lagged = true
+	return true
+}
+
+// headLag ... Returns the number of blocks a head is behind the unsafe head
+func headLag(unsafe, head eth.L2BlockRef) uint64 {
+	if head.Number >= unsafe.Number {
+		return 0
+	}
+
+	return unsafe.Number - head.Number
+}
@@ -0,0 +1,89 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func syncStatus(unsafe, safe, finalized uint64) *eth.SyncStatus {
+	return &eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: unsafe},
+		SafeL2:      eth.L2BlockRef{Number: safe},
+		FinalizedL2: eth.L2BlockRef{Number: finalized},
+	}
+}
+
+func TestSafeHeadLag(t *testing.T) {
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+	h, err := registry.NewSafeHeadLag(ctx, &registry.SafeHeadLagCfg{
+		MaxSafeLag:      10,
+		MaxFinalizedLag: 100,
+	})
+	assert.NoError(t, err)
+
+	assess := func(status *eth.SyncStatus) int {
+		ms.MockOpNode.EXPECT().SyncStatus(gomock.Any()).Return(status, nil).Times(1)
+
+		as, err := h.Assess(core.Event{
+			Type:    core.BlockHeader,
+			Network: core.Layer2,
+			Value:   types.Header{Number: big.NewInt(int64(status.UnsafeL2.Number))},
+		})
+		assert.NoError(t, err)
+		return as.Len()
+	}
+
+	// 1. Heads are within bounds
+	assert.Equal(t, 0, assess(syncStatus(100, 95, 50)))
+
+	// 2. Safe head falls behind; only alerted on once
+	assert.Equal(t, 1, assess(syncStatus(120, 100, 50)))
+	assert.Equal(t, 0, assess(syncStatus(121, 100, 50)))
+
+	// 3. Both heads fall behind
+	assert.Equal(t, 1, assess(syncStatus(200, 100, 50)))
+
+	// 4. Heads recover and safe head falls behind again
+	assert.Equal(t, 0, assess(syncStatus(201, 200, 150)))
+	assert.Equal(t, 1, assess(syncStatus(220, 200, 150)))
+
+	// 5. Sync status errors are surfaced
+	ms.MockOpNode.EXPECT().SyncStatus(gomock.Any()).Return(nil, fmt.Errorf("rpc error")).Times(1)
+	_, err = h.Assess(core.Event{
+		Type:    core.BlockHeader,
+		Network: core.Layer2,
+		Value:   types.Header{Number: big.NewInt(221)},
+	})
+	assert.Error(t, err)
+
+	// 6. Empty sync statuses are surfaced as errors
+	ms.MockOpNode.EXPECT().SyncStatus(gomock.Any()).Return(nil, nil).Times(1)
+	_, err = h.Assess(core.Event{
+		Type:    core.BlockHeader,
+		Network: core.Layer2,
+		Value:   types.Header{Number: big.NewInt(222)},
+	})
+	assert.Error(t, err)
+}
+
+func TestSafeHeadLagConfig(t *testing.T) {
+	ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+	_, err := registry.NewSafeHeadLag(ctx, &registry.SafeHeadLagCfg{})
+	assert.Error(t, err)
+
+	ms.Bundle.OpNode = nil
+	_, err = registry.NewSafeHeadLag(ctx, &registry.SafeHeadLagCfg{MaxSafeLag: 10})
+	assert.Error(t, err)
+}
@@ -0,0 +1,242 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StorageWatchCfg ... Configuration for the storage watch heuristic. When no allowed
+// values or bounds are provided, the heuristic alerts on any change to a slot
+type StorageWatchCfg struct {
+	Address string   `json:"address"`
+	Slots   []string `json:"args"`
+	// Allowed ... Set of 32 byte words the slots are allowed to hold
+	Allowed []string `json:"allowed"`
+	// Lower & Upper ... Inclusive integer bounds, either decimal, 0x prefixed hex or scientific notation
+	Lower *string `json:"lower"`
+	Upper *string `json:"upper"`
+}
+
+// Unmarshal ... Converts a general config to a storage watch heuristic config
+func (swc *StorageWatchCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &swc)
+}
+
+// slotObservation ... Last observed value of a storage slot
+type slotObservation struct {
+	value  common.Hash
+	height *big.Int
+}
+
+// StorageWatch ... Heuristic that monitors contract storage slots
+type StorageWatch struct {
+	cfg *StorageWatchCfg
+
+	slots   map[common.Hash]struct{}
+	allowed map[common.Hash]struct{}
+	lower   *big.Int
+	upper   *big.Int
+
+	mu   sync.Mutex
+	last map[common.Hash]slotObservation
+
+	heuristic.Heuristic
+}
+
+// slotChangeMsg ... Message to be sent to the alerting system when a slot changes
+const slotChangeMsg = `
+	_Storage Slot Changed_
+
+	Contract Address: %s
+	Slot: %s
+	Previous Value: %s
+	Current Value: %s
+	Block Number: %s
+`
+
+// slotViolationMsg ... Message to be sent to the alerting system when a slot
+// holds a value that isn't allowed
+const slotViolationMsg = `
+	_Storage Slot Out Of Bounds_
+
+	Contract Address: %s
+	Slot: %s
+	Current Value: %s
+	Allowed Values: %s
+	Lower bound: %s
+	Upper bound: %s
+	Block Number: %s
+`
+
+// NewStorageWatch ... Initializer
+func NewStorageWatch(cfg *StorageWatchCfg) (heuristic.Heuristic, error) {
+	sw := &StorageWatch{
+		cfg:     cfg,
+		slots:   make(map[common.Hash]struct{}, len(cfg.Slots)),
+		allowed: make(map[common.Hash]struct{}, len(cfg.Allowed)),
+		last:    make(map[common.Hash]slotObservation),
+
+		Heuristic: heuristic.New(core.StorageSlot, core.StorageWatch),
+	}
+
+	for _, slot := range cfg.Slots {
+		sw.slots[common.HexToHash(slot)] = struct{}{}
+	}
+
+	for _, val := range cfg.Allowed {
+		sw.allowed[common.HexToHash(val)] = struct{}{}
+	}
+
+	var err error
+	if sw.lower, err = parseBound("lower", cfg.Lower); err != nil {
+		return nil, err
+	}
+
+	if sw.upper, err = parseBound("upper", cfg.Upper); err != nil {
+		return nil, err
+	}
+
+	if sw.lower != nil && sw.upper != nil && sw.lower.Cmp(sw.upper) > 0 {
+		return nil, fmt.Errorf(invalidRangeErr, sw.lower, sw.upper)
+	}
+
+	return sw, nil
+}
+
+// parseBound ... Parses an optional integer bound
+func parseBound(name string, bound *string) (*big.Int, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	val, err := parseBig(*bound)
+	if err != nil {
+		return nil, fmt.Errorf(invalidBoundErr, name, *bound)
+	}
+
+	return val, nil
+}
+
+// constrained ... Returns true if the slots are restricted to an allowed set or range
+func (sw *StorageWatch) constrained() bool {
+	return len(sw.allowed) > 0 || sw.lower != nil || sw.upper != nil
+}
+
+// permitted ... Returns true if a value is in the allowed set and range
+func (sw *StorageWatch) permitted(value common.Hash) bool {
+	if len(sw.allowed) > 0 {
+		if _, found := sw.allowed[value]; !found {
+			return false
+		}
+	}
+
+	num := value.Big()
+	if sw.lower != nil && num.Cmp(sw.lower) < 0 {
+		return false
+	}
+
+	if sw.upper != nil && num.Cmp(sw.upper) > 0 {
+		return false
+	}
+
+	return true
+}
+
+// Inherit ... Carries over the last observed values of the slots that are still watched
+// when the replaced session monitors the same address
+func (sw *StorageWatch) Inherit(prev heuristic.Heuristic) {
+	p, ok := prev.(*StorageWatch)
+	if !ok || common.HexToAddress(p.cfg.Address) != common.HexToAddress(sw.cfg.Address) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for slot, obs := range p.last {
+		if _, watched := sw.slots[slot]; watched {
+			sw.last[slot] = obs
+		}
+	}
+}
+
+// Assess ... Checks if a storage slot changed or holds a value that isn't allowed
+func (sw *StorageWatch) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	// 1. Validate and extract the slot value from the event
+	err := sw.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Address != common.HexToAddress(sw.cfg.Address) {
+		return nil, fmt.Errorf(invalidAddrErr, sw.cfg.Address, e.Address.String())
+	}
+
+	sv, success := e.Value.(core.SlotValue)
+	if !success {
+		return nil, fmt.Errorf(couldNotCastErr, "SlotValue")
+	}
+
+	// 2. Ignore slots monitored by other sessions for the same address
+	if _, found := sw.slots[sv.Slot]; !found {
+		return heuristic.NoActivations(), nil
+	}
+
+	// 3. Check the value against the allowed set and range
+	if sw.constrained() {
+		if sw.permitted(sv.Value) {
+			return heuristic.NoActivations(), nil
+		}
+
+		return heuristic.NewActivationSet().Add(&heuristic.Activation{
+			TimeStamp: time.Now(),
+			Message: fmt.Sprintf(slotViolationMsg, sv.Address, sv.Slot, sv.Value,
+				sw.allowedString(), boundString(sw.lower, "-∞"), boundString(sw.upper, "∞"), sv.BlockNumber),
+		}), nil
+	}
+
+	// 4. Otherwise check if the value changed since the previous block
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	prev, seen := sw.last[sv.Slot]
+	if seen && prev.height != nil && sv.BlockNumber != nil && sv.BlockNumber.Cmp(prev.height) <= 0 {
+		return heuristic.NoActivations(), nil
+	}
+
+	sw.last[sv.Slot] = slotObservation{value: sv.Value, height: sv.BlockNumber}
+	if !seen || prev.value == sv.Value {
+		return heuristic.NoActivations(), nil
+	}
+
+	return heuristic.NewActivationSet().Add(&heuristic.Activation{
+		TimeStamp: time.Now(),
+		Message:   fmt.Sprintf(slotChangeMsg, sv.Address, sv.Slot, prev.value, sv.Value, sv.BlockNumber),
+	}), nil
+}
+
+// allowedString ... Returns the allowed values as a comma separated string
+func (sw *StorageWatch) allowedString() string {
+	if len(sw.cfg.Allowed) == 0 {
+		return "any"
+	}
+
+	return strings.Join(sw.cfg.Allowed, ", ")
+}
+
+// boundString ... Returns the string representation of an optional bound
+func boundString(bound *big.Int, unbounded string) string {
+	if bound == nil {
+		return unbounded
+	}
+
+	return bound.String()
+}
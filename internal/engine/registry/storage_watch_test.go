@@ -0,0 +1,106 @@
+package registry_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageWatch(t *testing.T) {
+	addr := common.HexToAddress("0x420")
+	slot := common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	other := common.HexToHash("0x1")
+
+	event := func(s common.Hash, val int64, height int64) core.Event {
+		return core.Event{
+			Type:    core.StorageSlot,
+			Address: addr,
+			Value: core.SlotValue{
+				Address:     addr,
+				Slot:        s,
+				Value:       common.BigToHash(big.NewInt(val)),
+				BlockNumber: big.NewInt(height),
+			},
+		}
+	}
+
+	str := func(s string) *string { return &s }
+
+	var tests = []struct {
+		name      string
+		cfg       *registry.StorageWatchCfg
+		events    []core.Event
+		activated []bool
+	}{
+		{
+			name:      "Activate when a slot changes",
+			cfg:       &registry.StorageWatchCfg{},
+			events:    []core.Event{event(slot, 1, 1), event(slot, 1, 2), event(slot, 2, 3)},
+			activated: []bool{false, false, true},
+		},
+		{
+			name:      "Ignore stale and unmonitored slots",
+			cfg:       &registry.StorageWatchCfg{},
+			events:    []core.Event{event(slot, 1, 2), event(slot, 2, 1), event(other, 2, 3)},
+			activated: []bool{false, false, false},
+		},
+		{
+			name: "Activate when a slot leaves the allowed set",
+			cfg: &registry.StorageWatchCfg{
+				Allowed: []string{common.BigToHash(big.NewInt(1)).Hex(), "0x2"},
+			},
+			events:    []core.Event{event(slot, 1, 1), event(slot, 2, 2), event(slot, 3, 3)},
+			activated: []bool{false, false, true},
+		},
+		{
+			name: "Activate when a slot leaves the allowed range",
+			cfg: &registry.StorageWatchCfg{
+				Lower: str("10"),
+				Upper: str("0x14"),
+			},
+			events:    []core.Event{event(slot, 9, 1), event(slot, 10, 2), event(slot, 20, 3), event(slot, 21, 4)},
+			activated: []bool{true, false, false, true},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			test.cfg.Address = addr.String()
+			test.cfg.Slots = []string{slot.Hex()}
+
+			h, err := registry.NewStorageWatch(test.cfg)
+			assert.NoError(t, err)
+
+			for j, e := range test.events {
+				as, err := h.Assess(e)
+				assert.NoError(t, err)
+				assert.Equal(t, test.activated[j], as.Activated(), "event %d", j)
+			}
+		})
+	}
+}
+
+func TestStorageWatchConfig(t *testing.T) {
+	lower, upper, invalid := "10", "1", "0xzz"
+
+	_, err := registry.NewStorageWatch(&registry.StorageWatchCfg{Lower: &lower, Upper: &upper})
+	assert.Error(t, err, "failure should occur when the lower bound exceeds the upper bound")
+
+	_, err = registry.NewStorageWatch(&registry.StorageWatchCfg{Lower: &invalid})
+	assert.Error(t, err, "failure should occur when a bound can't be parsed")
+
+	h, err := registry.NewStorageWatch(&registry.StorageWatchCfg{Address: "0x420"})
+	assert.NoError(t, err)
+
+	_, err = h.Assess(core.Event{
+		Type:    core.StorageSlot,
+		Address: common.HexToAddress("0x69"),
+		Value:   core.SlotValue{},
+	})
+	assert.Error(t, err, "failure should occur when the event address doesn't match")
+}
@@ -0,0 +1,186 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/base-org/pessimism/internal/common/math"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/heuristic"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DirectionFrom & DirectionTo ... Restrict the assessed transactions to those sent from or to an address
+const (
+	DirectionFrom = "from"
+	DirectionTo   = "to"
+)
+
+// TxWatchCfg ... Configuration for the transaction watch heuristic. When no filters
+// are provided, the heuristic alerts on every transaction sent from or to the address
+type TxWatchCfg struct {
+	Address string `json:"address"`
+	// Direction ... Either `from`, `to` or empty for both
+	Direction string `json:"direction"`
+	// Selectors ... Function selectors (e.g. 0xa9059cbb) or signatures (e.g. transfer(address,uint256))
+	Selectors []string `json:"selectors"`
+	// Threshold ... Transaction value (ETH) at or above which a transaction is alerted on
+	Threshold *float64 `json:"threshold"`
+	// Creations ... Only alert on contract deployments
+	Creations bool `json:"creations"`
+}
+
+// Unmarshal ... Converts a general config to a transaction watch heuristic config
+func (twc *TxWatchCfg) Unmarshal(isp *core.SessionParams) error {
+	return json.Unmarshal(isp.Bytes(), &twc)
+}
+
+// txWatchMsg ... Message to be sent to the alerting system when a transaction matches
+const txWatchMsg = `
+	_Transaction Observed_
+
+	Address: %s
+	Transaction Hash: %s
+	From: %s
+	To: %s
+	Value: %s ETH
+	Selector: %s
+	Block Number: %s
+`
+
+// TransactionWatch ... Heuristic that monitors the transactions sent from or to an address
+type TransactionWatch struct {
+	cfg *TxWatchCfg
+
+	addr      common.Address
+	selectors [][]byte
+
+	heuristic.Heuristic
+}
+
+// NewTransactionWatch ... Initializer
+func NewTransactionWatch(cfg *TxWatchCfg) (heuristic.Heuristic, error) {
+	switch cfg.Direction {
+	case "", DirectionFrom, DirectionTo:
+	default:
+		return nil, fmt.Errorf(invalidDirectionErr, cfg.Direction)
+	}
+
+	selectors := make([][]byte, 0, len(cfg.Selectors))
+	for _, s := range cfg.Selectors {
+		sel, err := parseSelector(s)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, sel)
+	}
+
+	return &TransactionWatch{
+		cfg:       cfg,
+		addr:      common.HexToAddress(cfg.Address),
+		selectors: selectors,
+
+		Heuristic: heuristic.New(core.Transaction, core.TransactionWatch),
+	}, nil
+}
+
+// parseSelector ... Parses a 0x prefixed function selector or derives it from a function signature
+func parseSelector(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return crypto.Keccak256([]byte(s))[:selectorLen], nil
+	}
+
+	sel, err := hexutil.Decode(s)
+	if err != nil || len(sel) != selectorLen {
+		return nil, fmt.Errorf(invalidSelectorErr, s)
+	}
+
+	return sel, nil
+}
+
+// Assess ... Checks if a transaction sent from or to the address matches the configured filters
+func (tw *TransactionWatch) Assess(e core.Event) (*heuristic.ActivationSet, error) {
+	// 1. Validate and extract the transaction from the event
+	err := tw.Validate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Address != tw.addr {
+		return nil, fmt.Errorf(invalidAddrErr, tw.cfg.Address, e.Address.String())
+	}
+
+	tx, success := e.Value.(core.Tx)
+	if !success {
+		return nil, fmt.Errorf(couldNotCastErr, "Tx")
+	}
+
+	// 2. Check the transaction against the filters
+	if !tw.matches(tx) {
+		return heuristic.NoActivations(), nil
+	}
+
+	to := "contract creation"
+	if tx.To() != nil {
+		to = tx.To().String()
+	}
+
+	selector := "none"
+	if len(tx.Data()) >= selectorLen && tx.To() != nil {
+		selector = hexutil.Encode(tx.Data()[:selectorLen])
+	}
+
+	return heuristic.NewActivationSet().Add(&heuristic.Activation{
+		TimeStamp: time.Now(),
+		Message: fmt.Sprintf(txWatchMsg, tw.cfg.Address, tx.Hash(), tx.From, to,
+			math.WeiToEther(tx.Value()).String(), selector, tx.BlockNumber),
+	}), nil
+}
+
+// matches ... Returns true if a transaction holds for every configured filter
+func (tw *TransactionWatch) matches(tx core.Tx) bool {
+	switch tw.cfg.Direction {
+	case DirectionFrom:
+		if tx.From != tw.addr {
+			return false
+		}
+
+	case DirectionTo:
+		if tx.To() == nil || *tx.To() != tw.addr {
+			return false
+		}
+	}
+
+	if tw.cfg.Creations && tx.To() != nil {
+		return false
+	}
+
+	if tw.cfg.Threshold != nil {
+		value, _ := math.WeiToEther(tx.Value()).Float64()
+		if value < *tw.cfg.Threshold {
+			return false
+		}
+	}
+
+	if len(tw.selectors) == 0 {
+		return true
+	}
+
+	if tx.To() == nil || len(tx.Data()) < selectorLen {
+		return false
+	}
+
+	for _, sel := range tw.selectors {
+		if bytes.Equal(tx.Data()[:selectorLen], sel) {
+			return true
+		}
+	}
+
+	return false
+}
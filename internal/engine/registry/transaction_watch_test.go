@@ -0,0 +1,114 @@
+package registry_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/engine/registry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionWatch(t *testing.T) {
+	wallet := common.HexToAddress("0x420")
+	other := common.HexToAddress("0x421")
+	transfer := hexutil.MustDecode("0xa9059cbb")
+
+	event := func(from common.Address, to *common.Address, ether int64, data []byte) core.Event {
+		return core.Event{
+			Type:    core.Transaction,
+			Address: wallet,
+			Value: core.Tx{
+				Transaction: types.NewTx(&types.LegacyTx{
+					To:    to,
+					Value: new(big.Int).Mul(big.NewInt(ether), big.NewInt(1e18)),
+					Data:  data,
+				}),
+				From:        from,
+				BlockNumber: big.NewInt(10),
+			},
+		}
+	}
+
+	threshold := 10.0
+
+	var tests = []struct {
+		name      string
+		cfg       *registry.TxWatchCfg
+		event     core.Event
+		activated bool
+	}{
+		{
+			name:      "Activate on any transaction without filters",
+			cfg:       &registry.TxWatchCfg{},
+			event:     event(other, &wallet, 0, nil),
+			activated: true,
+		},
+		{
+			name:  "No activation for a transaction in the other direction",
+			cfg:   &registry.TxWatchCfg{Direction: registry.DirectionFrom},
+			event: event(other, &wallet, 0, nil),
+		},
+		{
+			name:      "Activate on a large transfer from the wallet",
+			cfg:       &registry.TxWatchCfg{Direction: registry.DirectionFrom, Threshold: &threshold},
+			event:     event(wallet, &other, 10, nil),
+			activated: true,
+		},
+		{
+			name:  "No activation for a small transfer from the wallet",
+			cfg:   &registry.TxWatchCfg{Direction: registry.DirectionFrom, Threshold: &threshold},
+			event: event(wallet, &other, 9, nil),
+		},
+		{
+			name:      "Activate on a call to a monitored selector by signature",
+			cfg:       &registry.TxWatchCfg{Direction: registry.DirectionTo, Selectors: []string{"transfer(address,uint256)"}},
+			event:     event(other, &wallet, 0, append(transfer, make([]byte, 64)...)),
+			activated: true,
+		},
+		{
+			name:  "No activation for a call to an unmonitored selector",
+			cfg:   &registry.TxWatchCfg{Selectors: []string{"0x095ea7b3"}},
+			event: event(other, &wallet, 0, transfer),
+		},
+		{
+			name:      "Activate on a contract deployment",
+			cfg:       &registry.TxWatchCfg{Creations: true},
+			event:     event(wallet, nil, 0, transfer),
+			activated: true,
+		},
+		{
+			name:  "No activation for a call when only deployments are monitored",
+			cfg:   &registry.TxWatchCfg{Creations: true},
+			event: event(wallet, &other, 0, nil),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			test.cfg.Address = wallet.String()
+
+			h, err := registry.NewTransactionWatch(test.cfg)
+			assert.NoError(t, err)
+
+			as, err := h.Assess(test.event)
+			assert.NoError(t, err)
+			assert.Equal(t, test.activated, as.Activated())
+		})
+	}
+}
+
+func TestTransactionWatchConfig(t *testing.T) {
+	_, err := registry.NewTransactionWatch(&registry.TxWatchCfg{Direction: "sideways"})
+	assert.Error(t, err, "failure should occur when an unknown direction is provided")
+
+	_, err = registry.NewTransactionWatch(&registry.TxWatchCfg{Selectors: []string{"0xa9059c"}})
+	assert.Error(t, err, "failure should occur when a selector isn't 4 bytes")
+
+	_, err = registry.NewTransactionWatch(&registry.TxWatchCfg{Selectors: []string{"0xa9059cbb"}})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// IndexerCorrelation ... Correlates L1 withdrawal proofs using the OP Indexer API
+	IndexerCorrelation = "indexer"
+	// LocalCorrelation ... Correlates L1 withdrawal proofs using MessagePassed events
+	// observed on L2 by a withdrawal safety session, without an external indexer
+	LocalCorrelation = "local"
+
+	// maxIndexedWithdrawals ... Max number of withdrawals indexed by a session. Withdrawals that
+	// are never proven or finalized are pruned from the state store beyond this, oldest first
+	maxIndexedWithdrawals = 100_000
+)
+
+// withdrawalRecord ... A withdrawal initiated on L2 as observed from a MessagePassed event
+type withdrawalRecord struct {
+	Sender common.Address `json:"sender"`
+	Target common.Address `json:"target"`
+	Value  *big.Int       `json:"value"`
+	InitTx common.Hash    `json:"init_tx"`
+}
+
+// recordWithdrawal ... Indexes an observed MessagePassed event by withdrawal hash
+func recordWithdrawal(ctx context.Context, msgPasser common.Address,
+	msg *bindings.L2ToL1MessagePasserMessagePassed) error {
+	bytes, err := json.Marshal(&withdrawalRecord{
+		Sender: msg.Sender,
+		Target: msg.Target,
+		Value:  msg.Value,
+		InitTx: msg.Raw.TxHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	return state.SetValue(ctx, core.MakeWithdrawalKey(msgPasser, msg.WithdrawalHash), string(bytes))
+}
+
+// lookupWithdrawal ... Returns the indexed withdrawal for a withdrawal hash if one was observed
+func lookupWithdrawal(ctx context.Context, msgPasser common.Address,
+	hash common.Hash) (*withdrawalRecord, bool, error) {
+	val, found, err := state.GetValue(ctx, core.MakeWithdrawalKey(msgPasser, hash))
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	record := &withdrawalRecord{}
+	if err := json.Unmarshal([]byte(val), record); err != nil {
+		return nil, false, err
+	}
+
+	return record, true, nil
+}
+
+// removeWithdrawal ... Removes an indexed withdrawal once it has been finalized
+func removeWithdrawal(ctx context.Context, msgPasser common.Address, hash common.Hash) error {
+	ss, err := state.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ss.Remove(ctx, core.MakeWithdrawalKey(msgPasser, hash))
+}
+
+// pruneIndex ... Tracks a newly indexed withdrawal, removing the oldest indexed
+// withdrawals from the state store once the max index size is exceeded
+func (wsh *L2WithdrawalSafety) pruneIndex(hash common.Hash) error {
+	wsh.indexMu.Lock()
+	defer wsh.indexMu.Unlock()
+
+	wsh.indexed = append(wsh.indexed, hash)
+	for len(wsh.indexed) > maxIndexedWithdrawals {
+		if err := removeWithdrawal(wsh.ctx, common.HexToAddress(wsh.cfg.L2ToL1Address), wsh.indexed[0]); err != nil {
+			return err
+		}
+
+		wsh.indexed = wsh.indexed[1:]
+	}
+
+	return nil
+}
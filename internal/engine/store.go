@@ -2,14 +2,19 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
 )
 
+// Store ... Heuristic session store shared by the engine workers and API handlers
 type Store struct {
 	ids         map[core.PathID][]core.UUID
 	instanceMap map[core.UUID]heuristic.Heuristic // no duplicates
+	cfgMap      map[core.UUID]*heuristic.DeployConfig
+
+	mu sync.RWMutex
 }
 
 // NewStore ... Initializer
@@ -17,14 +22,18 @@ func NewStore() *Store {
 	return &Store{
 		instanceMap: make(map[core.UUID]heuristic.Heuristic),
 		ids:         make(map[core.PathID][]core.UUID),
+		cfgMap:      make(map[core.UUID]*heuristic.DeployConfig),
 	}
 }
 
 func (s *Store) GetHeuristics(ids []core.UUID) ([]heuristic.Heuristic, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	heuristics := make([]heuristic.Heuristic, len(ids))
 
 	for i, id := range ids {
-		session, err := s.GetHeuristic(id)
+		session, err := s.getHeuristic(id)
 		if err != nil {
 			return nil, err
 		}
@@ -36,21 +45,77 @@ func (s *Store) GetHeuristics(ids []core.UUID) ([]heuristic.Heuristic, error) {
 }
 
 func (s *Store) GetHeuristic(id core.UUID) (heuristic.Heuristic, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.getHeuristic(id)
+}
+
+func (s *Store) getHeuristic(id core.UUID) (heuristic.Heuristic, error) {
 	if entry, found := s.instanceMap[id]; found {
 		return entry, nil
 	}
 	return nil, fmt.Errorf("heuristic UUID doesn't exists in store heuristic mapping")
 }
 
+// GetSessionIDs ... Returns the UUIDs of all heuristic sessions in the store
+func (s *Store) GetSessionIDs() []core.UUID {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]core.UUID, 0, len(s.instanceMap))
+	for id := range s.instanceMap {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// tickSession ... Tick driven heuristic session along with its deploy config
+type tickSession struct {
+	h   heuristic.Heuristic
+	cfg *heuristic.DeployConfig
+}
+
+// tickSessions ... Returns a snapshot of the configured sessions that handle ticks
+func (s *Store) tickSessions() []tickSession {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sessions := make([]tickSession, 0)
+	for id, h := range s.instanceMap {
+		if _, ok := h.(heuristic.TickHandler); !ok {
+			continue
+		}
+
+		// Sessions are only ticked once their deployment has completed
+		cfg, found := s.cfgMap[id]
+		if !found {
+			continue
+		}
+
+		sessions = append(sessions, tickSession{h: h, cfg: cfg})
+	}
+
+	return sessions
+}
+
+// GetIDs ... Returns a copy of the session UUIDs bound to a path
 func (s *Store) GetIDs(id core.PathID) ([]core.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	if sessionIDs, found := s.ids[id]; found {
-		return sessionIDs, nil
+		return append([]core.UUID(nil), sessionIDs...), nil
 	}
 	return nil, fmt.Errorf("path UUID doesn't exists in store heuristic mapping")
 }
 
 func (s *Store) AddSession(uuid core.UUID,
 	id core.PathID, h heuristic.Heuristic) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if _, found := s.instanceMap[uuid]; found {
 		return fmt.Errorf("heuristic UUID already exists in store pid mapping")
 	}
@@ -64,7 +129,83 @@ func (s *Store) AddSession(uuid core.UUID,
 	return nil
 }
 
-func (s *Store) RemoveInvSession(_ core.UUID,
-	_ core.PathID, _ heuristic.Heuristic) error {
+// UpdateSession ... Replaces the heuristic instance of an existing session. Instances
+// that accumulate state inherit it from the replaced instance. State recorded by
+// in-flight assessments of the replaced instance after the swap is lost
+func (s *Store) UpdateSession(uuid core.UUID, h heuristic.Heuristic) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, found := s.instanceMap[uuid]
+	if !found {
+		return fmt.Errorf("heuristic UUID doesn't exists in store heuristic mapping")
+	}
+
+	if si, ok := h.(heuristic.StateInheritor); ok {
+		si.Inherit(prev)
+	}
+
+	s.instanceMap[uuid] = h
+	return nil
+}
+
+// SetConfig ... Stores the deploy config used to construct a heuristic session
+func (s *Store) SetConfig(uuid core.UUID, cfg *heuristic.DeployConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.instanceMap[uuid]; !found {
+		return fmt.Errorf("heuristic UUID doesn't exists in store heuristic mapping")
+	}
+
+	s.cfgMap[uuid] = cfg
+	return nil
+}
+
+// GetConfig ... Returns the deploy config for a heuristic session
+func (s *Store) GetConfig(uuid core.UUID) (*heuristic.DeployConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if cfg, found := s.cfgMap[uuid]; found {
+		return cfg, nil
+	}
+	return nil, fmt.Errorf("heuristic UUID doesn't exists in store config mapping")
+}
+
+// RemoveInvSession ... Removes a heuristic session and its path mapping from the store
+func (s *Store) RemoveInvSession(uuid core.UUID, id core.PathID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.instanceMap[uuid]; !found {
+		return fmt.Errorf("heuristic UUID doesn't exists in store heuristic mapping")
+	}
+
+	sessionIDs, found := s.ids[id]
+	if !found {
+		return fmt.Errorf("path UUID doesn't exists in store heuristic mapping")
+	}
+
+	// A new slice is built so that IDs already handed out to workers are left intact
+	remaining := make([]core.UUID, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		if sessionID != uuid {
+			remaining = append(remaining, sessionID)
+		}
+	}
+
+	if len(remaining) == len(sessionIDs) {
+		return fmt.Errorf("heuristic UUID doesn't exists in store pid mapping")
+	}
+
+	if len(remaining) == 0 {
+		delete(s.ids, id)
+	} else {
+		s.ids[id] = remaining
+	}
+
+	delete(s.instanceMap, uuid)
+	delete(s.cfgMap, uuid)
 	return nil
 }
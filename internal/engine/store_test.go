@@ -10,6 +10,16 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// inheritingHeuristic ... Heuristic that records the instance it inherited state from
+type inheritingHeuristic struct {
+	heuristic.Heuristic
+	inherited heuristic.Heuristic
+}
+
+func (ih *inheritingHeuristic) Inherit(prev heuristic.Heuristic) {
+	ih.inherited = prev
+}
+
 func TestSessionStore(t *testing.T) {
 	id1 := core.NewUUID()
 	id2 := core.NewUUID()
@@ -81,6 +91,9 @@ func TestSessionStore(t *testing.T) {
 				hs, err := ss.GetHeuristics([]core.UUID{id1, id2})
 				assert.NoError(t, err)
 				assert.Equal(t, hs, []heuristic.Heuristic{h, h2})
+
+				// Ensure that all session UUIDs are listed
+				assert.ElementsMatch(t, ss.GetSessionIDs(), []core.UUID{id1, id2})
 			},
 		},
 		{
@@ -122,6 +135,105 @@ func TestSessionStore(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "Successful Removal",
+			constructor: func() *engine.Store {
+				ss := engine.NewStore()
+
+				h := heuristic.New(core.TopicType(0), core.BalanceEnforcement)
+				h.SetID(id1)
+				_ = ss.AddSession(id1, core.PathID{}, h)
+				_ = ss.SetConfig(id1, &heuristic.DeployConfig{})
+
+				h2 := heuristic.New(core.TopicType(0), core.BalanceEnforcement)
+				h2.SetID(id2)
+				_ = ss.AddSession(id2, core.PathID{}, h2)
+
+				return ss
+			},
+			testFunc: func(t *testing.T, ss *engine.Store) {
+				err := ss.RemoveInvSession(id1, core.PathID{})
+				assert.NoError(t, err)
+
+				// Ensure that the removed heuristic is no longer retrievable
+				_, err = ss.GetHeuristic(id1)
+				assert.Error(t, err)
+
+				_, err = ss.GetConfig(id1)
+				assert.Error(t, err)
+
+				// Ensure that the remaining heuristic is still mapped to the path
+				ids, err := ss.GetIDs(core.PathID{})
+				assert.NoError(t, err)
+				assert.Equal(t, ids, []core.UUID{id2})
+
+				// Ensure that the path mapping is deleted once empty
+				err = ss.RemoveInvSession(id2, core.PathID{})
+				assert.NoError(t, err)
+
+				_, err = ss.GetIDs(core.PathID{})
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Failed Removal",
+			constructor: func() *engine.Store {
+				return engine.NewStore()
+			},
+			testFunc: func(t *testing.T, ss *engine.Store) {
+				err := ss.RemoveInvSession(id1, core.PathID{})
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Successful Update",
+			constructor: func() *engine.Store {
+				ss := engine.NewStore()
+
+				h := heuristic.New(core.TopicType(0), core.BalanceEnforcement)
+				h.SetID(id1)
+				_ = ss.AddSession(id1, core.PathID{}, h)
+
+				return ss
+			},
+			testFunc: func(t *testing.T, ss *engine.Store) {
+				h := heuristic.New(core.TopicType(1), core.BalanceEnforcement)
+				h.SetID(id1)
+
+				err := ss.UpdateSession(id1, h)
+				assert.NoError(t, err)
+
+				actual, err := ss.GetHeuristic(id1)
+				assert.NoError(t, err)
+				assert.Equal(t, h, actual)
+
+				// Ensure that unknown sessions can't be updated
+				err = ss.UpdateSession(id2, h)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Successful Update Inheriting State",
+			constructor: func() *engine.Store {
+				ss := engine.NewStore()
+
+				_ = ss.AddSession(id1, core.PathID{}, &inheritingHeuristic{
+					Heuristic: heuristic.New(core.TopicType(0), core.BalanceDelta),
+				})
+
+				return ss
+			},
+			testFunc: func(t *testing.T, ss *engine.Store) {
+				prev, err := ss.GetHeuristic(id1)
+				assert.NoError(t, err)
+
+				h := &inheritingHeuristic{Heuristic: heuristic.New(core.TopicType(0), core.BalanceDelta)}
+				assert.NoError(t, ss.UpdateSession(id1, h))
+
+				// Ensure that the new instance inherited from the replaced one
+				assert.Equal(t, prev, h.inherited)
+			},
+		},
 		{
 			name: "Failed Add with Duplicate IDs",
 			constructor: func() *engine.Store {
@@ -138,6 +250,30 @@ func TestSessionStore(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "Removal Leaves Retrieved IDs Intact",
+			constructor: func() *engine.Store {
+				ss := engine.NewStore()
+
+				_ = ss.AddSession(id1, core.PathID{}, heuristic.New(core.TopicType(0), core.BalanceEnforcement))
+				_ = ss.AddSession(id2, core.PathID{}, heuristic.New(core.TopicType(0), core.BalanceEnforcement))
+
+				return ss
+			},
+			testFunc: func(t *testing.T, ss *engine.Store) {
+				ids, err := ss.GetIDs(core.PathID{})
+				assert.NoError(t, err)
+
+				// Ensure that a slice read before the removal isn't modified by it
+				err = ss.RemoveInvSession(id1, core.PathID{})
+				assert.NoError(t, err)
+				assert.Equal(t, []core.UUID{id1, id2}, ids)
+
+				ids, err = ss.GetIDs(core.PathID{})
+				assert.NoError(t, err)
+				assert.Equal(t, []core.UUID{id2}, ids)
+			},
+		},
 	}
 
 	for i, test := range tests {
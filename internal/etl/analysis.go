@@ -46,6 +46,12 @@ func (a *analyzer) Mergable(path1 Path, path2 Path) bool {
 		return false
 	}
 
+	// Invalid if paths emit blocks at different confirmation depths
+	if path1.Config().ClientConfig.ConfirmationDepth !=
+		path2.Config().ClientConfig.ConfirmationDepth {
+		return false
+	}
+
 	// Invalid if paths do not share the same PID
 	if path1.UUID().ID != path2.UUID().ID {
 		return false
@@ -76,6 +76,36 @@ func Test_Mergable(t *testing.T) {
 				p2, err := etl.NewPath(testCfg, id2, processes)
 				assert.NoError(t, err)
 
+				assert.False(t, a.Mergable(p1, p2))
+			},
+		},
+		{
+			name:        "Failure Path Merge With Different Confirmation Depths",
+			description: "Mergable function should return false when confirmation depths do not match",
+			construction: func() etl.Analyzer {
+				r := registry.New()
+				return etl.NewAnalyzer(r)
+			},
+			test: func(t *testing.T, a etl.Analyzer) {
+				reader, err := mocks.NewReader(context.Background(), core.BlockHeader)
+				assert.NoError(t, err)
+
+				processes := []process.Process{reader}
+				id1 := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0), core.MakeProcessID(core.Live, 0, 0, 0))
+				id2 := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0), core.MakeProcessID(core.Live, 0, 0, 0))
+
+				p1, err := etl.NewPath(&core.PathConfig{
+					PathType:     core.Live,
+					ClientConfig: &core.ClientConfig{ConfirmationDepth: 0},
+				}, id1, processes)
+				assert.NoError(t, err)
+
+				p2, err := etl.NewPath(&core.PathConfig{
+					PathType:     core.Live,
+					ClientConfig: &core.ClientConfig{ConfirmationDepth: 10},
+				}, id2, processes)
+				assert.NoError(t, err)
+
 				assert.False(t, a.Mergable(p1, p2))
 			},
 		},
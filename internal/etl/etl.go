@@ -14,6 +14,7 @@ import (
 
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/state"
 
 	"go.uber.org/zap"
 )
@@ -23,9 +24,15 @@ type ETL interface {
 		dt *core.DataTopic) (process.Process, error)
 	GetStateKey(rt core.TopicType) (*core.StateKey, bool, error)
 	GetBlockHeight(id core.PathID) (*big.Int, error)
+	GetCheckpoint(id core.PathID) (*big.Int, error)
+	SetCheckpoint(id core.PathID, height *big.Int) error
+	GetPath(id core.UUID) (*core.PathInfo, error)
+	GetPaths() []*core.PathInfo
 	CreateProcessPath(cfg *core.PathConfig) (core.PathID, bool, error)
+	RemovePath(id core.PathID) error
 	Run(id core.PathID) error
 	ActiveCount() int
+	HealthEvents() chan core.PathHealthEvent
 
 	core.Subsystem
 }
@@ -40,8 +47,17 @@ type etl struct {
 
 	egress chan core.HeuristicInput
 
+	// changes ... Process state changes consumed by the path supervisor
+	changes  chan process.StateChange
+	health   chan core.PathHealthEvent
+	restarts map[core.PathID]*restartCount
+
 	registry *registry.Registry
 	wg       sync.WaitGroup
+
+	// pathMu ... Serializes path creation, startup and teardown across the
+	// API handlers and the path supervisor
+	pathMu sync.Mutex
 }
 
 // New ... Initializer
@@ -57,6 +73,9 @@ func New(ctx context.Context, analyzer Analyzer, r *registry.Registry,
 		store:    store,
 		registry: r,
 		egress:   eo,
+		changes:  make(chan process.StateChange, changeBufferSize),
+		health:   make(chan core.PathHealthEvent, changeBufferSize),
+		restarts: make(map[core.PathID]*restartCount),
 		metrics:  stats,
 		wg:       sync.WaitGroup{},
 	}
@@ -72,6 +91,9 @@ func (etl *etl) CreateProcessPath(cfg *core.PathConfig) (core.PathID, bool, erro
 	// code logic fails, then some rollback will need be triggered to undo prior applied state operations
 	logger := logging.WithContext(etl.ctx)
 
+	etl.pathMu.Lock()
+	defer etl.pathMu.Unlock()
+
 	depPath, err := etl.registry.TopicPath(cfg.DataType)
 	if err != nil {
 		return core.PathID{}, false, err
@@ -115,6 +137,9 @@ func (etl *etl) CreateProcessPath(cfg *core.PathConfig) (core.PathID, bool, erro
 
 // RunPath ...
 func (etl *etl) Run(id core.PathID) error {
+	etl.pathMu.Lock()
+	defer etl.pathMu.Unlock()
+
 	// 1. Get path from store
 	path, err := etl.store.GetPathByID(id)
 	if err != nil {
@@ -135,17 +160,78 @@ func (etl *etl) Run(id core.PathID) error {
 	return nil
 }
 
-// EventLoop ... Driver ran as separate go routine
+// RemovePath ... Tears down a path by removing its processes from the graph
+// and deleting it from the store
+func (etl *etl) RemovePath(id core.PathID) error {
+	etl.pathMu.Lock()
+	defer etl.pathMu.Unlock()
+
+	path, err := etl.store.GetPathByID(id)
+	if err != nil {
+		return err
+	}
+
+	// Processes are ordered from the most downstream process to the upstream reader,
+	// ensuring that no process is removed while it still has downstream edges
+	for _, p := range path.Processes() {
+		if !etl.dag.Exists(p.ID()) { // Path was never ran
+			p.SetState(process.Terminated)
+			continue
+		}
+
+		if err := etl.dag.Remove(p.ID()); err != nil {
+			return err
+		}
+	}
+
+	wasActive := path.State() == ACTIVE
+	if err := path.Close(); err != nil {
+		return err
+	}
+
+	// Removed paths shouldn't resume from their last block once recreated
+	if ck := path.Processes()[0].CheckpointKey(); ck != nil {
+		if err := state.RemoveCheckpoint(etl.ctx, ck); err != nil {
+			logging.WithContext(etl.ctx).Warn("Could not remove path checkpoint",
+				zap.String(logging.Path, id.String()), zap.Error(err))
+		}
+	}
+
+	if err := etl.store.RemovePath(id); err != nil {
+		return err
+	}
+
+	if wasActive {
+		etl.metrics.DecActivePaths(id.NetworkType())
+	}
+
+	logging.WithContext(etl.ctx).Info("Removed path",
+		zap.String(logging.Path, id.String()))
+
+	return nil
+}
+
+// EventLoop ... Driver ran as separate go routine that supervises path processes
 func (etl *etl) EventLoop() error {
 	logger := logging.WithContext(etl.ctx)
 
 	for {
-		<-etl.ctx.Done()
-		logger.Info("Shutting down ETL")
-		return nil
+		select {
+		case sc := <-etl.changes:
+			etl.supervise(sc)
+
+		case <-etl.ctx.Done():
+			logger.Info("Shutting down ETL")
+			return nil
+		}
 	}
 }
 
+// HealthEvents ... Returns the channel used to surface path health changes
+func (etl *etl) HealthEvents() chan core.PathHealthEvent {
+	return etl.health
+}
+
 // Shutdown ... Shuts down all paths
 func (etl *etl) Shutdown() error {
 	etl.cancel()
@@ -217,7 +303,8 @@ func (etl *etl) CreateProcess(cc *core.ClientConfig, id core.ProcessID, pathID c
 		zap.String("register_type", dt.DataType.String()))
 
 	// embed options to avoid constructor boilerplate
-	opts := []process.Option{process.WithID(id), process.WithPathID(pathID)}
+	opts := []process.Option{process.WithID(id), process.WithPathID(pathID),
+		process.WithEventChan(etl.changes)}
 
 	if dt.Stateful() {
 		// Propagate state key to process so that it can be used
@@ -231,6 +318,12 @@ func (etl *etl) CreateProcess(cc *core.ClientConfig, id core.ProcessID, pathID c
 		opts = append(opts, process.WithStateKey(sk))
 	}
 
+	// Explicit backfills run from their configured heights and aren't resumed
+	if cc != nil && !cc.Backfill() {
+		opts = append(opts, process.WithCheckpointKey(
+			core.MakeCheckpointKey(pathID.ID, cc.ConfirmationDepth)))
+	}
+
 	switch dt.ProcessType {
 	case core.Read:
 		init, success := dt.Constructor.(process.Constructor)
@@ -253,6 +346,55 @@ func (etl *etl) CreateProcess(cc *core.ClientConfig, id core.ProcessID, pathID c
 	}
 }
 
+// GetPath ... Returns a runtime snapshot of a path provided its UUID
+func (etl *etl) GetPath(id core.UUID) (*core.PathInfo, error) {
+	path, err := etl.store.GetPathByUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pathInfo(path), nil
+}
+
+// GetPaths ... Returns runtime snapshots of all paths in the store
+func (etl *etl) GetPaths() []*core.PathInfo {
+	paths := etl.store.Paths()
+	infos := make([]*core.PathInfo, len(paths))
+
+	for i, path := range paths {
+		infos[i] = pathInfo(path)
+	}
+
+	return infos
+}
+
+// pathInfo ... Builds a runtime snapshot of a path
+func pathInfo(path Path) *core.PathInfo {
+	// Paths that haven't read any blocks yet have no height
+	height, err := path.BlockHeight()
+	if err != nil {
+		height = nil
+	}
+
+	lag, err := path.BlockLag()
+	if err != nil {
+		lag = nil
+	}
+
+	procs := make([]core.ProcessID, len(path.Processes()))
+	for i, p := range path.Processes() {
+		procs[i] = p.ID()
+	}
+
+	return &core.PathInfo{
+		ID:        path.UUID(),
+		State:     path.State().String(),
+		Height:    height,
+		Lag:       lag,
+		Processes: procs,
+	}
+}
+
 // GetStateKey ... Returns a state key provided a register type
 func (etl *etl) GetStateKey(rt core.TopicType) (*core.StateKey, bool, error) {
 	dr, err := etl.registry.GetDataTopic(rt)
@@ -280,3 +422,43 @@ func (etl *etl) GetBlockHeight(id core.PathID) (*big.Int, error) {
 
 	return height, nil
 }
+
+// GetCheckpoint ... Returns the last fully processed block height of a path
+func (etl *etl) GetCheckpoint(id core.PathID) (*big.Int, error) {
+	ck, err := etl.checkpointKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.GetCheckpoint(etl.ctx, ck)
+}
+
+// SetCheckpoint ... Sets the block height a path resumes after once ran. Existing
+// checkpoints are only advanced so that a path never reprocesses recorded blocks
+func (etl *etl) SetCheckpoint(id core.PathID, height *big.Int) error {
+	ck, err := etl.checkpointKey(id)
+	if err != nil {
+		return err
+	}
+
+	if current, err := state.GetCheckpoint(etl.ctx, ck); err == nil && current.Cmp(height) >= 0 {
+		return nil
+	}
+
+	return state.SetCheckpoint(etl.ctx, ck, height)
+}
+
+// checkpointKey ... Returns the checkpoint key of a path
+func (etl *etl) checkpointKey(id core.PathID) (*core.StateKey, error) {
+	path, err := etl.store.GetPathByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ck := path.Processes()[0].CheckpointKey()
+	if ck == nil { // Explicit backfills aren't resumed
+		return nil, fmt.Errorf(noCheckpointErr, id.String())
+	}
+
+	return ck, nil
+}
@@ -179,6 +179,182 @@ func Test_Graph(t *testing.T) {
 
 			},
 		},
+		{
+			name:        "Successful Edge Removal",
+			function:    "RemoveEdge",
+			description: "When an edge exists between two processes (A->B), it should be removable",
+
+			constructionLogic: func() *etl.Graph {
+				g := etl.NewGraph()
+
+				comp1, err := mocks.NewReader(context.Background(), core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id1, comp1); err != nil {
+					panic(err)
+				}
+
+				comp2, err := mocks.NewSubscriber(context.Background(), core.BlockHeader, core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id2, comp2); err != nil {
+					panic(err)
+				}
+
+				if err = g.Subscribe(id1, id2); err != nil {
+					panic(err)
+				}
+
+				return g
+			},
+
+			testLogic: func(t *testing.T, g *etl.Graph) {
+				err := g.RemoveEdge(id1, id2)
+				assert.NoError(t, err)
+
+				edgeMap := g.Edges()
+				assert.Len(t, edgeMap[id1], 0, "ID1 should have no mapped edges")
+
+				// Upstream process should no longer hold a subscription to the downstream process
+				comp1, _ := g.GetProcess(id1)
+				err = comp1.AddSubscriber(id2, core.NewTransitChannel())
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:        "Failed Edge Removal",
+			function:    "RemoveEdge",
+			description: "When no edge exists between two processes, removal should fail",
+
+			constructionLogic: func() *etl.Graph {
+				g := etl.NewGraph()
+
+				comp1, err := mocks.NewReader(context.Background(), core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id1, comp1); err != nil {
+					panic(err)
+				}
+
+				comp2, err := mocks.NewSubscriber(context.Background(), core.BlockHeader, core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id2, comp2); err != nil {
+					panic(err)
+				}
+
+				if err = g.Subscribe(id1, id2); err != nil {
+					panic(err)
+				}
+
+				return g
+			},
+
+			testLogic: func(t *testing.T, g *etl.Graph) {
+				err := g.RemoveEdge(id2, id1)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:        "Successful Process Removal",
+			function:    "Remove",
+			description: "When a process has no downstream edges, it should be removed and its relays closed",
+
+			constructionLogic: func() *etl.Graph {
+				g := etl.NewGraph()
+
+				comp1, err := mocks.NewReader(context.Background(), core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id1, comp1); err != nil {
+					panic(err)
+				}
+
+				comp2, err := mocks.NewSubscriber(context.Background(), core.BlockHeader, core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id2, comp2); err != nil {
+					panic(err)
+				}
+
+				if err = g.Subscribe(id1, id2); err != nil {
+					panic(err)
+				}
+
+				return g
+			},
+
+			testLogic: func(t *testing.T, g *etl.Graph) {
+				comp2, _ := g.GetProcess(id2)
+				relay, err := comp2.GetRelay(core.BlockHeader)
+				assert.NoError(t, err)
+
+				err = g.Remove(id2)
+				assert.NoError(t, err)
+
+				assert.False(t, g.Exists(id2))
+				assert.Len(t, g.Edges()[id1], 0, "ID1 should have no mapped edges")
+
+				_, open := <-relay
+				assert.False(t, open, "Relay should be closed")
+
+				// Upstream process can now be removed
+				err = g.Remove(id1)
+				assert.NoError(t, err)
+				assert.False(t, g.Exists(id1))
+			},
+		},
+		{
+			name:        "Failed Process Removal With Downstream Edges",
+			function:    "Remove",
+			description: "When a process has downstream edges, it should not be removable",
+
+			constructionLogic: func() *etl.Graph {
+				g := etl.NewGraph()
+
+				comp1, err := mocks.NewReader(context.Background(), core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id1, comp1); err != nil {
+					panic(err)
+				}
+
+				comp2, err := mocks.NewSubscriber(context.Background(), core.BlockHeader, core.BlockHeader)
+				if err != nil {
+					panic(err)
+				}
+
+				if err = g.Add(id2, comp2); err != nil {
+					panic(err)
+				}
+
+				if err = g.Subscribe(id1, id2); err != nil {
+					panic(err)
+				}
+
+				return g
+			},
+
+			testLogic: func(t *testing.T, g *etl.Graph) {
+				err := g.Remove(id1)
+				assert.Error(t, err)
+				assert.True(t, g.Exists(id1))
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -2,6 +2,7 @@ package etl
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/etl/process"
@@ -30,16 +31,24 @@ func NewGraph() *Graph {
 // Represents a directed acyclic process graph (DAG)
 type Graph struct {
 	edgeMap map[core.ProcessID]*node
+
+	mu sync.RWMutex
 }
 
 func (graph *Graph) Exists(id core.ProcessID) bool {
+	graph.mu.RLock()
+	defer graph.mu.RUnlock()
+
 	_, exists := graph.edgeMap[id]
 	return exists
 }
 
 func (graph *Graph) GetProcess(id core.ProcessID) (process.Process, error) {
-	if graph.Exists(id) {
-		return graph.edgeMap[id].p, nil
+	graph.mu.RLock()
+	defer graph.mu.RUnlock()
+
+	if n, exists := graph.edgeMap[id]; exists {
+		return n.p, nil
 	}
 
 	return nil, fmt.Errorf(procNotFoundErr, id)
@@ -57,6 +66,13 @@ NOTE - There is no check to ensure that a cyclic edge is being added, meaning
 
 // Adds subscription or edge between two preconstructed constructed process nodes
 func (graph *Graph) Subscribe(from, to core.ProcessID) error {
+	graph.mu.Lock()
+	defer graph.mu.Unlock()
+
+	return graph.subscribe(from, to)
+}
+
+func (graph *Graph) subscribe(from, to core.ProcessID) error {
 	fromNode, found := graph.edgeMap[from]
 	if !found {
 		return fmt.Errorf(procNotFoundErr, from.String())
@@ -86,19 +102,91 @@ func (graph *Graph) Subscribe(from, to core.ProcessID) error {
 	return nil
 }
 
-// TODO(#23): Manager DAG process Removal Support
-// removeEdge ... Removes an edge from the graph
-func (graph *Graph) RemoveEdge(_, _ core.ProcessID) error {
+// RemoveEdge ... Removes an edge from the graph by unsubscribing
+// the downstream process from the upstream process
+func (graph *Graph) RemoveEdge(from, to core.ProcessID) error {
+	graph.mu.Lock()
+	defer graph.mu.Unlock()
+
+	return graph.removeEdge(from, to)
+}
+
+func (graph *Graph) removeEdge(from, to core.ProcessID) error {
+	fromNode, found := graph.edgeMap[from]
+	if !found {
+		return fmt.Errorf(procNotFoundErr, from.String())
+	}
+
+	if _, exists := fromNode.edges[to]; !exists {
+		return fmt.Errorf(edgeNotFoundErr, from.String(), to.String())
+	}
+
+	if err := fromNode.p.RemoveSubscriber(to); err != nil {
+		return err
+	}
+
+	delete(fromNode.edges, to)
 	return nil
 }
 
-// TODO(#23): Manager DAG process Removal Support
-// Remove ... Removes a process from the graph
-func (graph *Graph) Remove(_ core.ProcessID) error {
+// Remove ... Removes a process from the graph. The process is unsubscribed from all
+// upstream processes, its event loop is stopped and its relays are closed.
+// Processes with downstream edges cannot be removed
+func (graph *Graph) Remove(id core.ProcessID) error {
+	graph.mu.Lock()
+	defer graph.mu.Unlock()
+
+	n, found := graph.edgeMap[id]
+	if !found {
+		return fmt.Errorf(procNotFoundErr, id.String())
+	}
+
+	if len(n.edges) > 0 {
+		return fmt.Errorf(downstreamEdgesErr, id.String(), len(n.edges))
+	}
+
+	// 1. Unsubscribe from upstream processes
+	relays := make([]core.TopicType, 0)
+	for upID, upNode := range graph.edgeMap {
+		if _, exists := upNode.edges[id]; !exists {
+			continue
+		}
+
+		if err := graph.removeEdge(upID, id); err != nil {
+			return err
+		}
+
+		relays = append(relays, upNode.outType)
+	}
+
+	// 2. Stop the process event loop
+	if n.p.ActivityState() == process.Live {
+		if err := n.p.Close(); err != nil {
+			return err
+		}
+	}
+	n.p.SetState(process.Terminated)
+
+	// 3. Close relays that were fed by upstream processes. This is safe since
+	// RemoveSubscriber waits for any in-flight upstream send to be released
+	for _, tt := range relays {
+		if err := n.p.RemoveRelay(tt); err != nil {
+			return err
+		}
+	}
+
+	delete(graph.edgeMap, id)
 	return nil
 }
 
 func (graph *Graph) Add(id core.ProcessID, p process.Process) error {
+	graph.mu.Lock()
+	defer graph.mu.Unlock()
+
+	return graph.add(id, p)
+}
+
+func (graph *Graph) add(id core.ProcessID, p process.Process) error {
 	if _, exists := graph.edgeMap[id]; exists {
 		return fmt.Errorf(procExistsErr, id)
 	}
@@ -109,16 +197,19 @@ func (graph *Graph) Add(id core.ProcessID, p process.Process) error {
 }
 
 func (graph *Graph) AddMany(processes []process.Process) error {
+	graph.mu.Lock()
+	defer graph.mu.Unlock()
+
 	// Add all process entries to graph
 	for _, p := range processes {
-		if err := graph.Add(p.ID(), p); err != nil {
+		if err := graph.add(p.ID(), p); err != nil {
 			return err
 		}
 	}
 
 	// Add edges between processes
 	for i := 1; i < len(processes); i++ {
-		err := graph.Subscribe(processes[i].ID(), processes[i-1].ID())
+		err := graph.subscribe(processes[i].ID(), processes[i-1].ID())
 		if err != nil {
 			return err
 		}
@@ -127,6 +218,9 @@ func (graph *Graph) AddMany(processes []process.Process) error {
 }
 
 func (graph *Graph) Edges() map[core.ProcessID][]core.ProcessID {
+	graph.mu.RLock()
+	defer graph.mu.RUnlock()
+
 	uuidMap := make(map[core.ProcessID][]core.ProcessID, len(graph.edgeMap))
 
 	for id, cEntry := range graph.edgeMap {
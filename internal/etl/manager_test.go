@@ -3,6 +3,7 @@ package etl
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -111,6 +112,92 @@ func TestETL(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name:        "Successful Path Removal",
+			function:    "RemovePath",
+			description: "RemovePath should tear down a running path and delete it from the store",
+
+			constructionLogic: func() ETL {
+				reg := registry.New()
+				ctrl := gomock.NewController(t)
+
+				ctx, ms := mocks.Context(context.Background(), ctrl)
+
+				ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).Return(nil, fmt.Errorf("keep going")).AnyTimes()
+
+				ctx = context.WithValue(ctx, core.State, state.NewMemState())
+
+				return New(ctx, NewAnalyzer(reg), reg, NewStore(), NewGraph(), nil)
+			},
+
+			testLogic: func(t *testing.T, etl ETL) {
+				pCfg := &core.PathConfig{
+					Network:  core.Layer1,
+					DataType: core.Log,
+					PathType: core.Live,
+					ClientConfig: &core.ClientConfig{
+						Network:      core.Layer1,
+						PollInterval: time.Hour * 1,
+					},
+				}
+
+				id, _, err := etl.CreateProcessPath(pCfg)
+				assert.NoError(t, err)
+
+				err = etl.Run(id)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, etl.ActiveCount())
+
+				err = etl.RemovePath(id)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, etl.ActiveCount())
+
+				_, err = etl.GetBlockHeight(id)
+				assert.Error(t, err)
+
+				// A new path should be created rather than reusing the removed one
+				id2, reuse, err := etl.CreateProcessPath(pCfg)
+				assert.NoError(t, err)
+				assert.False(t, reuse)
+				assert.NotEqual(t, id, id2)
+			},
+		},
+		{
+			name:     "Success - Checkpoint Only Advances",
+			function: "SetCheckpoint",
+
+			constructionLogic: func() ETL {
+				reg := registry.New()
+				ctx, _ := mocks.Context(context.Background(), gomock.NewController(t))
+				ctx = context.WithValue(ctx, core.State, state.NewMemState())
+
+				return New(ctx, NewAnalyzer(reg), reg, NewStore(), NewGraph(), nil)
+			},
+
+			testLogic: func(t *testing.T, etl ETL) {
+				id, _, err := etl.CreateProcessPath(&core.PathConfig{
+					Network:  core.Layer1,
+					DataType: core.BlockHeader,
+					PathType: core.Live,
+					ClientConfig: &core.ClientConfig{
+						Network:      core.Layer1,
+						PollInterval: time.Hour * 1,
+					},
+				})
+				assert.NoError(t, err)
+
+				// Paths that haven't processed any blocks have no checkpoint
+				_, err = etl.GetCheckpoint(id)
+				assert.Error(t, err)
+
+				assert.NoError(t, etl.SetCheckpoint(id, big.NewInt(100)))
+				assert.NoError(t, etl.SetCheckpoint(id, big.NewInt(50)))
+
+				height, err := etl.GetCheckpoint(id)
+				assert.NoError(t, err)
+				assert.Equal(t, big.NewInt(100), height)
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -14,6 +14,7 @@ import (
 // Process path
 type Path interface {
 	BlockHeight() (*big.Int, error)
+	BlockLag() (*big.Int, error)
 	Config() *core.PathConfig
 	Processes() []process.Process
 	UUID() core.PathID
@@ -21,6 +22,7 @@ type Path interface {
 
 	Close() error
 	Run(wg *sync.WaitGroup)
+	Restart(id core.ProcessID, wg *sync.WaitGroup) error
 	AddEngineRelay(engineChan chan core.HeuristicInput) error
 }
 
@@ -31,6 +33,8 @@ type path struct {
 	state ActivityState
 
 	processes []process.Process
+
+	*sync.RWMutex
 }
 
 // NewPath ... Initializer
@@ -44,15 +48,26 @@ func NewPath(cfg *core.PathConfig, id core.PathID, procs []process.Process) (Pat
 		id:        id,
 		processes: procs,
 		state:     INACTIVE,
+		RWMutex:   &sync.RWMutex{},
 	}
 
 	return p, nil
 }
 
 func (path *path) State() ActivityState {
+	path.RLock()
+	defer path.RUnlock()
+
 	return path.state
 }
 
+func (path *path) setState(as ActivityState) {
+	path.Lock()
+	defer path.Unlock()
+
+	path.state = as
+}
+
 func (path *path) Config() *core.PathConfig {
 	return path.cfg
 }
@@ -66,6 +81,26 @@ func (path *path) UUID() core.PathID {
 }
 
 func (path *path) BlockHeight() (*big.Int, error) {
+	cr, err := path.reader()
+	if err != nil {
+		return nil, err
+	}
+
+	return cr.Height()
+}
+
+// BlockLag ... Returns the number of confirmed blocks the path is behind the chain head
+func (path *path) BlockLag() (*big.Int, error) {
+	cr, err := path.reader()
+	if err != nil {
+		return nil, err
+	}
+
+	return cr.Lag()
+}
+
+// reader ... Returns the chain reader at the start of the path
+func (path *path) reader() (*process.ChainReader, error) {
 	// We assume that all paths have an oracle as their last process
 	p := path.processes[len(path.processes)-1]
 	cr, ok := p.(*process.ChainReader)
@@ -73,7 +108,7 @@ func (path *path) BlockHeight() (*big.Int, error) {
 		return nil, fmt.Errorf("could not cast process to chain reader")
 	}
 
-	return cr.Height()
+	return cr, nil
 }
 
 // AddEngineRelay ... Adds a relay to the path that forces it to send transformed heuristic input
@@ -92,34 +127,59 @@ func (path *path) AddEngineRelay(engineChan chan core.HeuristicInput) error {
 // Run  ... Spawns process event loops
 func (path *path) Run(wg *sync.WaitGroup) {
 	for _, p := range path.processes {
-		wg.Add(1)
+		path.runProcess(p, wg)
+	}
 
-		go func(p process.Process, wg *sync.WaitGroup) {
-			defer wg.Done()
+	path.setState(ACTIVE)
+}
 
-			logging.NoContext().
-				Debug("Starting process",
-					zap.String(logging.Process, p.ID().String()),
-					zap.String(logging.Path, p.ID().String()))
+// Restart ... Respawns the event loop of a crashed path process
+func (path *path) Restart(id core.ProcessID, wg *sync.WaitGroup) error {
+	for _, p := range path.processes {
+		if p.ID() != id {
+			continue
+		}
 
-			if err := p.EventLoop(); err != nil {
-				// NOTE - Consider killing the entire path if one process fails
-				// Otherwise dangling processes will be left in a running state
-				logging.NoContext().Error("Obtained error from event loop", zap.Error(err),
-					zap.String(logging.Process, p.ID().String()),
-					zap.String(logging.Path, p.ID().String()))
-				path.state = CRASHED
-			}
-		}(p, wg)
+		if p.ActivityState() != process.Crashed {
+			return fmt.Errorf(procNotCrashedErr, id.String())
+		}
+
+		path.runProcess(p, wg)
+		path.setState(ACTIVE)
+		return nil
 	}
 
-	path.state = ACTIVE
+	return fmt.Errorf(procNotFoundErr, id.String())
+}
+
+// runProcess ... Spawns a process event loop
+func (path *path) runProcess(p process.Process, wg *sync.WaitGroup) {
+	wg.Add(1)
+	p.SetState(process.Live)
+
+	go func(p process.Process, wg *sync.WaitGroup) {
+		defer wg.Done()
+
+		logging.NoContext().
+			Debug("Starting process",
+				zap.String(logging.Process, p.ID().String()),
+				zap.String(logging.Path, p.ID().String()))
+
+		// Crashed processes notify the ETL supervisor, which either
+		// restarts them or tears down the path
+		if err := p.EventLoop(); err != nil {
+			logging.NoContext().Error("Obtained error from event loop", zap.Error(err),
+				zap.String(logging.Process, p.ID().String()),
+				zap.String(logging.Path, p.ID().String()))
+			path.setState(CRASHED)
+		}
+	}(p, wg)
 }
 
-// Close ... Closes all processes in the path
+// Close ... Closes all live processes in the path
 func (path *path) Close() error {
 	for _, p := range path.processes {
-		if p.ActivityState() != process.Terminated {
+		if p.ActivityState() == process.Live {
 			logging.NoContext().
 				Debug("Shutting down path process",
 					zap.String(logging.Process, p.ID().String()),
@@ -130,6 +190,6 @@ func (path *path) Close() error {
 			}
 		}
 	}
-	path.state = TERMINATED
+	path.setState(TERMINATED)
 	return nil
 }
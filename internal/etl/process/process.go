@@ -1,13 +1,23 @@
 package process
 
 import (
+	"context"
+	"math/big"
 	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
 )
 
 const (
 	killSig = 0
+
+	// relayBufferSize ... Buffer size of the default state change relay used
+	// when no event channel is provided
+	relayBufferSize = 1
 )
 
 type Process interface {
@@ -22,10 +32,12 @@ type Process interface {
 	EventLoop() error
 
 	AddSubscriber(id core.ProcessID, outChan chan core.Event) error
+	RemoveSubscriber(id core.ProcessID) error
 	SetState(as ActivityState)
 
 	AddRelay(tt core.TopicType) error
 	GetRelay(tt core.TopicType) (chan core.Event, error)
+	RemoveRelay(tt core.TopicType) error
 
 	AddEngineRelay(relay *core.ExecInputRelay) error
 
@@ -35,6 +47,7 @@ type Process interface {
 	Type() core.ProcessType
 	EmitType() core.TopicType
 	StateKey() *core.StateKey
+	CheckpointKey() *core.StateKey
 	// TODO(#24): Add Internal Process Activity State Tracking
 	ActivityState() ActivityState
 }
@@ -52,6 +65,10 @@ type State struct {
 	close chan int
 
 	sk *core.StateKey
+	// ck ... State key of the path's last fully processed block height
+	ck *core.StateKey
+	// height ... Last checkpointed height; only accessed by the process's event loop
+	height *big.Int
 
 	*topics
 	*subscribers
@@ -60,6 +77,8 @@ type State struct {
 }
 
 func newState(pt core.ProcessType, tt core.TopicType) *State {
+	mu := &sync.RWMutex{}
+
 	return &State{
 		id:     core.ProcessID{},
 		pathID: core.PathID{},
@@ -69,29 +88,57 @@ func newState(pt core.ProcessType, tt core.TopicType) *State {
 		publType: tt,
 
 		close: make(chan int),
-		relay: make(chan StateChange),
+		relay: make(chan StateChange, relayBufferSize),
 		subscribers: &subscribers{
-			subs: make(map[core.ProcIdentifier]chan core.Event),
+			mu:   mu,
+			subs: make(map[core.ProcIdentifier]*subscriber),
 		},
 		topics: &topics{
 			relays: make(map[core.TopicType]chan core.Event),
 		},
-		RWMutex: &sync.RWMutex{},
+		RWMutex: mu,
 	}
 }
 
 func (s *State) ActivityState() ActivityState {
+	s.RLock()
+	defer s.RUnlock()
+
 	return s.as
 }
 
 func (s *State) SetState(as ActivityState) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.as = as
+}
+
+// transition ... Sets the activity state and returns the corresponding state change
+func (s *State) transition(as ActivityState, err error) StateChange {
+	s.Lock()
+	defer s.Unlock()
+
+	event := StateChange{
+		ID:     s.id,
+		PathID: s.pathID,
+		From:   s.as,
+		To:     as,
+		Err:    err,
+	}
+
 	s.as = as
+	return event
 }
 
 func (s *State) StateKey() *core.StateKey {
 	return s.sk
 }
 
+func (s *State) CheckpointKey() *core.StateKey {
+	return s.ck
+}
+
 func (s *State) ID() core.ProcessID {
 	return s.id
 }
@@ -109,14 +156,71 @@ func (s *State) EmitType() core.TopicType {
 }
 
 func (s *State) emit(as ActivityState) {
-	event := StateChange{
-		ID:   s.id,
-		From: s.as,
-		To:   as,
+	s.relay <- s.transition(as, nil) // Send to upstream consumers
+}
+
+// crash ... Marks the process as crashed and notifies upstream consumers
+// of the failure so that the process can be restarted. The notification is
+// dropped rather than blocking the process when no consumer can receive it
+func (s *State) crash(err error) error {
+	select {
+	case s.relay <- s.transition(Crashed, err):
+	default:
+		logging.NoContext().Warn("Could not relay process crash",
+			zap.String(logging.Process, s.id.String()),
+			zap.Error(err))
 	}
 
-	s.as = as
-	s.relay <- event // Send to upstream consumers
+	return err
+}
+
+// checkpoint ... Records the last fully processed block height once an event has been
+// relayed to the risk engine, allowing the path to resume after it on restart
+func (s *State) checkpoint(ctx context.Context, e core.Event) {
+	if s.ck == nil || !s.HasEngineRelay() {
+		return
+	}
+
+	height, ok := completedHeight(e)
+	if !ok || height.Sign() < 0 || (s.height != nil && s.height.Cmp(height) == 0) {
+		return
+	}
+
+	if err := state.SetCheckpoint(ctx, s.ck, height); err != nil {
+		logging.WithContext(ctx).Warn("Could not record path checkpoint",
+			zap.String(logging.Path, s.pathID.String()),
+			zap.Error(err))
+		return
+	}
+
+	s.height = height
+}
+
+// completedHeight ... Returns the height of the last block fully processed once an event
+// has been handled. Block headers complete their own block, whereas events derived from
+// a block (e.g. transactions) are only known to complete the blocks preceding it
+func completedHeight(e core.Event) (*big.Int, bool) {
+	var number *big.Int
+
+	switch v := e.Value.(type) {
+	case types.Header:
+		return v.Number, v.Number != nil
+
+	case core.Tx:
+		number = v.BlockNumber
+
+	case core.TxReceipt:
+		number = v.Tx.BlockNumber
+
+	default:
+		return nil, false
+	}
+
+	if number == nil {
+		return nil, false
+	}
+
+	return new(big.Int).Sub(number, big.NewInt(1)), true
 }
 
 type Option = func(*State)
@@ -143,3 +247,9 @@ func WithStateKey(sk *core.StateKey) Option {
 		s.sk = sk
 	}
 }
+
+func WithCheckpointKey(ck *core.StateKey) Option {
+	return func(s *State) {
+		s.ck = ck
+	}
+}
@@ -41,6 +41,32 @@ func Test_State(t *testing.T) {
 				assert.Equal(t, s1.ID, core.ProcessID{})
 			},
 		},
+		{
+			name:        "Test Crash Emit",
+			description: "When crash is called, the process should be marked as crashed and the error relayed",
+			function:    "crash",
+
+			constructionLogic: func() *State {
+				s := newState(0, 0)
+				s.as = Live
+				return s
+			},
+
+			testLogic: func(t *testing.T, s *State) {
+				crashErr := fmt.Errorf("rpc unavailable")
+
+				go func() {
+					_ = s.crash(crashErr)
+				}()
+
+				s1 := <-s.relay
+
+				assert.Equal(t, Live, s1.From)
+				assert.Equal(t, Crashed, s1.To)
+				assert.Equal(t, crashErr, s1.Err)
+				assert.Equal(t, Crashed, s.ActivityState())
+			},
+		},
 	}
 	for i, tc := range tests {
 		t.Run(fmt.Sprintf("%d-%s", i, tc.name), func(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 type Routine interface {
 	Loop(ctx context.Context, processChan chan core.Event) error
 	Height() (*big.Int, error)
+	Lag() (*big.Int, error)
 }
 
 // ChainReader ...
@@ -55,6 +56,10 @@ func (cr *ChainReader) Height() (*big.Int, error) {
 	return cr.routine.Height()
 }
 
+func (cr *ChainReader) Lag() (*big.Int, error) {
+	return cr.routine.Lag()
+}
+
 func (cr *ChainReader) Close() error {
 	cr.close <- killSig
 	cr.wg.Wait()
@@ -73,14 +78,13 @@ func (cr *ChainReader) EventLoop() error {
 	cr.wg.Add(1)
 
 	jobCtx, cancel := context.WithCancel(cr.ctx)
+	jobErr := make(chan error, 1)
 
 	// Run job
 	go func() {
 		defer cr.wg.Done()
 		if err := cr.routine.Loop(jobCtx, cr.jobEvents); err != nil {
-			logger.Error("Received error from read routine",
-				zap.String(logging.Process, cr.id.String()),
-				zap.Error(err))
+			jobErr <- err
 		}
 	}()
 
@@ -93,6 +97,8 @@ func (cr *ChainReader) EventLoop() error {
 
 			if err := cr.subscribers.Publish(event); err != nil {
 				logger.Error(relayErr, zap.String(logging.Session, cr.id.String()))
+			} else {
+				cr.checkpoint(cr.ctx, event)
 			}
 
 			if cr.subscribers.None() {
@@ -101,6 +107,13 @@ func (cr *ChainReader) EventLoop() error {
 					RecordPathLatency(cr.PathID(), latency)
 			}
 
+		case err := <-jobErr:
+			logger.Error("Received error from read routine",
+				zap.String(logging.Process, cr.id.String()),
+				zap.Error(err))
+			cancel()
+			return cr.crash(err)
+
 		case <-cr.close:
 			logger.Debug("Shutting down process",
 				zap.String(logging.Process, cr.id.String()))
@@ -60,16 +60,25 @@ func (sub *Subscriber) EventLoop() error {
 
 	relay, err := sub.GetRelay(sub.tt)
 	if err != nil {
-		return err
+		return sub.crash(err)
 	}
 
 	for {
 		select {
 		case event := <-relay:
 
-			events, err := sub.spt.Run(sub.ctx, event)
-			if err != nil {
-				logger.Error(err.Error(), zap.String("ID", sub.id.String()))
+			// Reorg notifications bypass the subscription transformation
+			// and are forwarded as is to downstream consumers
+			if event.Type == core.Reorg {
+				if err := sub.subscribers.Publish(event); err != nil {
+					logger.Error(relayErr, zap.String("ID", sub.id.String()))
+				}
+				continue
+			}
+
+			events, runErr := sub.spt.Run(sub.ctx, event)
+			if runErr != nil {
+				logger.Error(runErr.Error(), zap.String("ID", sub.id.String()))
 			}
 
 			if sub.subscribers.None() {
@@ -85,6 +94,9 @@ func (sub *Subscriber) EventLoop() error {
 				zap.Int("Length", length))
 
 			if length == 0 {
+				if runErr == nil {
+					sub.checkpoint(sub.ctx, event)
+				}
 				continue
 			}
 
@@ -94,6 +106,8 @@ func (sub *Subscriber) EventLoop() error {
 
 			if err := sub.subscribers.PublishBatch(events); err != nil {
 				logger.Error(relayErr, zap.String("ID", sub.id.String()))
+			} else if runErr == nil {
+				sub.checkpoint(sub.ctx, event)
 			}
 
 		// Manager is telling us to shutdown
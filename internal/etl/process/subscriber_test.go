@@ -3,15 +3,19 @@ package process_test
 import (
 	"context"
 	"log"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
 	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -83,3 +87,76 @@ func TestSubscription(t *testing.T) {
 	assert.Equal(t, outputData.Timestamp, ts, "Timestamp failed to verify")
 
 }
+
+func TestSubscriberCheckpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, _ = mocks.Context(ctx, gomock.NewController(t))
+
+	ck := core.MakeCheckpointKey(core.PathIdentifier{}, 0)
+	sub, err := mocks.NewSubscriber(ctx, core.BlockHeader, core.Log, process.WithCheckpointKey(ck))
+	assert.NoError(t, err)
+
+	engineChan := make(chan core.HeuristicInput)
+	assert.NoError(t, sub.AddEngineRelay(core.NewEngineRelay(core.PathID{}, engineChan)))
+
+	go func() {
+		if err := sub.EventLoop(); err != nil {
+			log.Printf("Got error from subscriber event loop %s", err.Error())
+		}
+	}()
+
+	relay, err := sub.GetRelay(core.BlockHeader)
+	assert.NoError(t, err)
+
+	relay <- core.Event{
+		Type:  core.BlockHeader,
+		Value: types.Header{Number: big.NewInt(420)},
+	}
+
+	// Header should only be checkpointed once it's been relayed to the risk engine
+	<-engineChan
+
+	assert.Eventually(t, func() bool {
+		height, err := state.GetCheckpoint(ctx, ck)
+		return err == nil && height.Cmp(big.NewInt(420)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSubscriberTransactionCheckpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, _ = mocks.Context(ctx, gomock.NewController(t))
+
+	ck := core.MakeCheckpointKey(core.PathIdentifier{}, 0)
+	sub, err := mocks.NewSubscriber(ctx, core.Transaction, core.Receipt, process.WithCheckpointKey(ck))
+	assert.NoError(t, err)
+
+	engineChan := make(chan core.HeuristicInput)
+	assert.NoError(t, sub.AddEngineRelay(core.NewEngineRelay(core.PathID{}, engineChan)))
+
+	go func() {
+		if err := sub.EventLoop(); err != nil {
+			log.Printf("Got error from subscriber event loop %s", err.Error())
+		}
+	}()
+
+	relay, err := sub.GetRelay(core.Transaction)
+	assert.NoError(t, err)
+
+	// Transactions only complete the blocks preceding their own
+	for _, number := range []int64{420, 420, 421} {
+		relay <- core.Event{
+			Type:  core.Transaction,
+			Value: core.Tx{BlockNumber: big.NewInt(number)},
+		}
+		<-engineChan
+	}
+
+	assert.Eventually(t, func() bool {
+		height, err := state.GetCheckpoint(ctx, ck)
+		return err == nil && height.Cmp(big.NewInt(420)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -2,37 +2,88 @@ package process
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
 )
 
+// subscriber ... Relay of a downstream process subscribed to a process's output
+type subscriber struct {
+	relay chan core.Event
+	// done ... Closed once the subscriber is removed to release sends blocked on its relay
+	done chan struct{}
+	// sending ... Tracks in-flight sends so that the relay isn't closed mid-send
+	sending sync.WaitGroup
+}
+
+func newSubscriber(relay chan core.Event) *subscriber {
+	return &subscriber{
+		relay: relay,
+		done:  make(chan struct{}),
+	}
+}
+
+// send ... Sends an event to the subscriber unless it's removed while blocked
+func (sub *subscriber) send(e core.Event) {
+	select {
+	case sub.relay <- e:
+	case <-sub.done:
+	}
+}
+
 type subscribers struct {
-	subs map[core.ProcIdentifier]chan core.Event
+	// mu ... Shared process state mutex guarding subs, which are mutated
+	// by the graph while the process event loop publishes to them
+	mu   *sync.RWMutex
+	subs map[core.ProcIdentifier]*subscriber
 
 	relay *core.ExecInputRelay
 }
 
 func (s *subscribers) None() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return len(s.subs) == 0 && s.HasEngineRelay()
 }
 
+// snapshot ... Returns the current subscribers, each marked as having an in-flight send
+func (s *subscribers) snapshot() []*subscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subs := make([]*subscriber, 0, len(s.subs))
+	for _, sub := range s.subs {
+		sub.sending.Add(1)
+		subs = append(subs, sub)
+	}
+
+	return subs
+}
+
+// Publish ... Sends an event to the engine relay and all subscribers. Sends happen
+// outside of the shared state mutex so that a slow downstream consumer doesn't stall
+// the process's state transitions. A subscriber removed mid-send is skipped
 func (s *subscribers) Publish(e core.Event) error {
-	if len(s.subs) == 0 && !s.HasEngineRelay() {
+	subs := s.snapshot()
+	if len(subs) == 0 && !s.HasEngineRelay() {
 		return fmt.Errorf(noSubErr)
 	}
 
+	var err error
 	if s.HasEngineRelay() {
-		if err := s.relay.RelayEvent(e); err != nil {
-			return err
-		}
+		err = s.relay.RelayEvent(e)
 	}
 
 	// NOTE - Consider introducing a fail safe timeout to ensure that freezing on clogged chanel buffers is recognized
-	for _, channel := range s.subs {
-		channel <- e
+	for _, sub := range subs {
+		if err == nil {
+			sub.send(e)
+		}
+		sub.sending.Done()
 	}
 
-	return nil
+	return err
 }
 
 func (s *subscribers) PublishBatch(dataSlice []core.Event) error {
@@ -49,20 +100,32 @@ func (s *subscribers) PublishBatch(dataSlice []core.Event) error {
 }
 
 func (s *subscribers) AddSubscriber(id core.ProcessID, topic chan core.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if _, found := s.subs[id.ID]; found {
 		return fmt.Errorf(subExistsErr, id.String())
 	}
 
-	s.subs[id.ID] = topic
+	s.subs[id.ID] = newSubscriber(topic)
 	return nil
 }
 
+// RemoveSubscriber ... Removes a subscriber, releasing and waiting for any in-flight
+// sends to it so that its relay can safely be closed once this returns
 func (s *subscribers) RemoveSubscriber(id core.ProcessID) error {
-	if _, found := s.subs[id.ID]; !found {
+	s.mu.Lock()
+	sub, found := s.subs[id.ID]
+	if !found {
+		s.mu.Unlock()
 		return fmt.Errorf(subNotFound, id.ID.String())
 	}
 
 	delete(s.subs, id.ID)
+	close(sub.done)
+	s.mu.Unlock()
+
+	sub.sending.Wait()
 	return nil
 }
 
@@ -2,6 +2,7 @@ package process
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -23,7 +24,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 
 			construction: func() *subscribers {
 				return &subscribers{
-					subs: make(map[core.ProcIdentifier]chan core.Event),
+					mu:   &sync.RWMutex{},
+					subs: make(map[core.ProcIdentifier]*subscriber),
 				}
 			},
 
@@ -53,7 +55,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 				outChan := make(chan core.Event)
 
 				s := &subscribers{
-					subs: make(map[core.ProcIdentifier]chan core.Event),
+					mu:   &sync.RWMutex{},
+					subs: make(map[core.ProcIdentifier]*subscriber),
 				}
 				if err := s.AddSubscriber(id, outChan); err != nil {
 					panic(err)
@@ -80,7 +83,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 				outChan := make(chan core.Event)
 
 				s := &subscribers{
-					subs: make(map[core.ProcIdentifier]chan core.Event),
+					mu:   &sync.RWMutex{},
+					subs: make(map[core.ProcIdentifier]*subscriber),
 				}
 				if err := s.AddSubscriber(id, outChan); err != nil {
 					panic(err)
@@ -107,7 +111,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 				outChan := make(chan core.Event)
 
 				s := &subscribers{
-					subs: make(map[core.ProcIdentifier]chan core.Event),
+					mu:   &sync.RWMutex{},
+					subs: make(map[core.ProcIdentifier]*subscriber),
 				}
 				if err := s.AddSubscriber(id, outChan); err != nil {
 					panic(err)
@@ -130,7 +135,7 @@ func TestAddRemoveSubscription(t *testing.T) {
 			description: "When a relay is passed to AddRelay, it should be used during transit operations",
 
 			construction: func() *subscribers {
-				return &subscribers{}
+				return &subscribers{mu: &sync.RWMutex{}}
 			},
 			test: func(t *testing.T, s *subscribers) {
 				relayChan := make(chan core.HeuristicInput)
@@ -167,7 +172,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 
 				relay := core.NewEngineRelay(core.PathID{}, relayChan)
 				s := &subscribers{
-					subs: make(map[core.ProcIdentifier]chan core.Event),
+					mu:   &sync.RWMutex{},
+					subs: make(map[core.ProcIdentifier]*subscriber),
 				}
 
 				if err := s.AddEngineRelay(relay); err != nil {
@@ -200,7 +206,8 @@ func TestAddRemoveSubscription(t *testing.T) {
 
 func TestPublishToSubscribers(t *testing.T) {
 	s := &subscribers{
-		subs: make(map[core.ProcIdentifier]chan core.Event),
+		mu:   &sync.RWMutex{},
+		subs: make(map[core.ProcIdentifier]*subscriber),
 	}
 
 	var subs = []struct {
@@ -246,3 +253,44 @@ func TestPublishToSubscribers(t *testing.T) {
 	}
 
 }
+
+func TestRemoveSubscriberDuringPublish(t *testing.T) {
+	s := &subscribers{
+		mu:   &sync.RWMutex{},
+		subs: make(map[core.ProcIdentifier]*subscriber),
+	}
+
+	id := core.MakeProcessID(1, 54, 43, 32)
+	outChan := make(chan core.Event)
+	assert.NoError(t, s.AddSubscriber(id, outChan))
+
+	published := make(chan struct{})
+	go func() {
+		assert.NoError(t, s.Publish(core.Event{Network: 1}))
+		close(published)
+	}()
+
+	// Wait for the publish to block on the subscriber channel
+	time.Sleep(10 * time.Millisecond)
+
+	// The blocked publish doesn't hold the shared state mutex
+	locked := make(chan struct{})
+	go func() {
+		assert.NoError(t, s.AddSubscriber(core.MakeProcessID(2, 54, 43, 32), make(chan core.Event, 1)))
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("state mutex was held during an in-flight publish")
+	}
+
+	// Removal releases the in-flight publish, after which the relay can be closed
+	assert.NoError(t, s.RemoveSubscriber(id))
+	<-published
+	close(outChan)
+
+	_, exists := s.subs[id.ID]
+	assert.False(t, exists)
+}
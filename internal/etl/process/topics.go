@@ -19,6 +19,19 @@ func (p *topics) GetRelay(rt core.TopicType) (chan core.Event, error) {
 	return val, nil
 }
 
+// RemoveRelay ... Closes and removes the relay for a topic type
+func (p *topics) RemoveRelay(rt core.TopicType) error {
+	relay, found := p.relays[rt]
+	if !found {
+		return fmt.Errorf(topicNotFoundErr, rt.String())
+	}
+
+	close(relay)
+	delete(p.relays, rt)
+
+	return nil
+}
+
 func (p *topics) AddRelay(rt core.TopicType) error {
 	if _, found := p.relays[rt]; found {
 		return fmt.Errorf(topicExistsErr, rt.String())
@@ -56,6 +56,51 @@ func TestAddRemoveTopic(t *testing.T) {
 
 			},
 		},
+		{
+			name:        "Successful Remove Test",
+			description: "When an existing register type is removed, its relay should be closed and deleted",
+
+			construct: func() *topics {
+				p := &topics{
+					relays: make(map[core.TopicType]chan core.Event),
+				}
+
+				if err := p.AddRelay(core.BlockHeader); err != nil {
+					panic(err)
+				}
+				return p
+			},
+
+			test: func(t *testing.T, p *topics) {
+				relay, err := p.GetRelay(core.BlockHeader)
+				assert.NoError(t, err)
+
+				err = p.RemoveRelay(core.BlockHeader)
+				assert.NoError(t, err)
+
+				_, open := <-relay
+				assert.False(t, open)
+
+				_, err = p.GetRelay(core.BlockHeader)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:        "Failed Remove Test",
+			description: "When a non-existent register type is removed, an error should be returned",
+
+			construct: func() *topics {
+				return &topics{
+					relays: make(map[core.TopicType]chan core.Event),
+				}
+			},
+
+			test: func(t *testing.T, p *topics) {
+				err := p.RemoveRelay(core.BlockHeader)
+				assert.Error(t, err)
+				assert.Equal(t, err.Error(), fmt.Sprintf(topicNotFoundErr, core.BlockHeader.String()))
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -11,6 +11,7 @@ type ActivityState int
 const (
 	Inactive ActivityState = iota
 	Live
+	Crashed
 	Terminated
 )
 
@@ -22,6 +23,9 @@ func (as ActivityState) String() string {
 	case Live:
 		return "live"
 
+	case Crashed:
+		return "crashed"
+
 	case Terminated:
 		return "terminated"
 	}
@@ -31,10 +35,14 @@ func (as ActivityState) String() string {
 
 // Denotes a process state change
 type StateChange struct {
-	ID core.ProcessID
+	ID     core.ProcessID
+	PathID core.PathID
 
 	From ActivityState // S
 	To   ActivityState // S'
+
+	// Err ... Error that caused the process to crash
+	Err error
 }
 
 const (
@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// HeadSubscription ... Header traversal routine driven by newHeads notifications
+// from a WebSocket RPC endpoint rather than polling. Dropped subscriptions are
+// re-established on every poll interval and any blocks mined while disconnected
+// are traversed once reconnected
+type HeadSubscription struct {
+	*HeaderTraversal
+
+	sub client.HeadSubscriber
+	// fallback ... Poll for new headers while the subscription is down
+	fallback bool
+}
+
+// Loop ... Traverses new headers as soon as their heads are announced
+func (hs *HeadSubscription) Loop(ctx context.Context, consumer chan core.Event) error {
+	logger := logging.WithContext(ctx)
+
+	ticker := time.NewTicker(hs.interval())
+	defer ticker.Stop()
+
+	if err := hs.start(ctx, ticker, consumer); err != nil {
+		if ctx.Err() != nil { // Shutdown before the traversal started
+			return nil
+		}
+
+		return err
+	}
+
+	heads := make(chan *types.Header)
+	var sub ethereum.Subscription
+	var subErr <-chan error
+
+	defer func() {
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}()
+
+	for {
+		if sub == nil {
+			s, err := hs.sub.SubscribeNewHead(ctx, heads)
+			if err != nil {
+				logger.Warn("Failed to subscribe to new heads",
+					zap.String(logging.Path, hs.pathID.String()),
+					zap.Error(err))
+			} else {
+				logger.Info("Subscribed to new heads",
+					zap.String(logging.Path, hs.pathID.String()))
+
+				sub, subErr = s, s.Err()
+				// Traverse blocks mined since the subscription was last active
+				hs.poll(ctx, consumer)
+			}
+		}
+
+		select {
+		case header := <-heads:
+			hs.head.Store(header.Number)
+			hs.catchUp(ctx, consumer)
+
+		case err := <-subErr:
+			logger.Warn("New heads subscription dropped; reconnecting",
+				zap.String(logging.Path, hs.pathID.String()),
+				zap.Error(err))
+
+			sub.Unsubscribe()
+			sub, subErr = nil, nil
+
+		case <-ticker.C:
+			if sub == nil && hs.fallback {
+				hs.poll(ctx, consumer)
+			}
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -2,14 +2,20 @@ package registry
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/base-org/pessimism/internal/client"
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/etl/process"
 	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/state"
 	ix_node "github.com/ethereum-optimism/optimism/indexer/node"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"go.uber.org/zap"
 )
@@ -17,6 +23,17 @@ import (
 const (
 	// This could be configurable in the future
 	batchSize = 100
+
+	// reorgWindow ... Number of recently emitted headers retained for reorg detection
+	reorgWindow = 64
+
+	// defaultPollInterval ... Poll interval used when none is configured
+	defaultPollInterval = 1 * time.Second
+
+	reorgWindowErr   = "could not find common ancestor for reorg; no headers tracked"
+	reorgAncestorErr = "could not find common ancestor for reorg"
+	noHeightErr      = "no headers have been traversed yet"
+	noHeadErr        = "chain head has not been fetched yet"
 )
 
 type HeaderTraversal struct {
@@ -27,9 +44,19 @@ type HeaderTraversal struct {
 	client       ix_node.EthClient
 	traversal    *ix_node.HeaderTraversal
 	pollInterval time.Duration
+	confDepth    *big.Int
 
-	// TODO - Add height metric
-	// stats metrics.Metricer
+	// recent ... Window of most recently emitted headers used to detect reorgs
+	recent []types.Header
+	// head ... Most recently polled chain head height
+	head atomic.Pointer[big.Int]
+	// height ... Height of the most recently tracked header, read concurrently
+	// with the traversal being rewound
+	height atomic.Pointer[big.Int]
+	// ck ... State key of the path's last fully processed block height
+	ck *core.StateKey
+
+	stats metrics.Metricer
 }
 
 func NewHeaderTraversal(ctx context.Context, cfg *core.ClientConfig,
@@ -54,97 +81,468 @@ func NewHeaderTraversal(ctx context.Context, cfg *core.ClientConfig,
 		startHeader = header
 	}
 
-	// TODO - Support network confirmation counts
+	confDepth := new(big.Int).SetUint64(cfg.ConfirmationDepth)
 
 	ht := &HeaderTraversal{
 		n:            cfg.Network,
 		client:       node,
-		traversal:    ix_node.NewHeaderTraversal(node, startHeader, big.NewInt(0)),
+		traversal:    ix_node.NewHeaderTraversal(node, startHeader, confDepth),
 		pollInterval: cfg.PollInterval,
+		confDepth:    confDepth,
+		stats:        metrics.WithContext(ctx),
 	}
 
-	reader, err := process.NewReader(ctx, core.BlockHeader, ht, opts...)
+	// Subscribe to new heads when a WebSocket endpoint is configured for the network
+	var routine process.Routine = ht
+	if sub, ok := clients.HeadSubscriber(cfg.Network); ok {
+		routine = &HeadSubscription{
+			HeaderTraversal: ht,
+			sub:             sub,
+			fallback:        cfg.PollFallback,
+		}
+	}
+
+	reader, err := process.NewReader(ctx, core.BlockHeader, routine, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	ht.id = reader.ID()
 	ht.pathID = reader.PathID()
+	ht.ck = reader.CheckpointKey()
 	return reader, nil
 }
 
 // Height ... Current block height
 func (ht *HeaderTraversal) Height() (*big.Int, error) {
-	return ht.traversal.LastHeader().Number, nil
+	height := ht.height.Load()
+	if height == nil {
+		return nil, fmt.Errorf(noHeightErr)
+	}
+
+	return new(big.Int).Set(height), nil
 }
 
+// Lag ... Number of confirmed blocks between the chain head and the last traversed header
+func (ht *HeaderTraversal) Lag() (*big.Int, error) {
+	head := ht.head.Load()
+	if head == nil {
+		return nil, fmt.Errorf(noHeadErr)
+	}
+
+	height, err := ht.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	lag := new(big.Int).Sub(head, ht.confDepth)
+	lag.Sub(lag, height)
+	if lag.Sign() < 0 {
+		return big.NewInt(0), nil
+	}
+
+	return lag, nil
+}
+
+// Backfill ... Emits all headers from the start height up to and including the end height.
+// Blocks in batches that can't be fetched, or that the provider omits, are recorded as missed
 func (ht *HeaderTraversal) Backfill(start, end *big.Int, consumer chan core.Event) error {
-	for i := start; i.Cmp(end) < 0; i.Add(i, big.NewInt(batchSize)) {
-		end := big.NewInt(0).Add(i, big.NewInt(batchSize))
+	// Copy the start height to avoid mutating the caller's header number
+	for i := new(big.Int).Set(start); i.Cmp(end) <= 0; i.Add(i, big.NewInt(batchSize)) {
+		batchEnd := new(big.Int).Add(i, big.NewInt(batchSize-1))
+		if batchEnd.Cmp(end) > 0 {
+			batchEnd.Set(end)
+		}
 
-		headers, err := ht.client.BlockHeadersByRange(i, end)
+		headers, err := ht.client.BlockHeadersByRange(i, batchEnd)
 		if err != nil {
-			return err
+			logging.NoContext().Warn("Failed to backfill headers",
+				zap.String(logging.Path, ht.pathID.String()),
+				zap.String("start", i.String()),
+				zap.String("end", batchEnd.String()),
+				zap.Error(err))
+
+			ht.missed(i, batchEnd)
+			continue
 		}
 
+		// Providers can return fewer headers than requested
+		expected := new(big.Int).Set(i)
 		for _, header := range headers {
-			consumer <- core.Event{
-				Timestamp: time.Now(),
-				Type:      core.BlockHeader,
-				Value:     header,
+			if header.Number.Cmp(expected) > 0 {
+				ht.missed(expected, new(big.Int).Sub(header.Number, big.NewInt(1)))
 			}
+
+			ht.publish(header, consumer)
+			expected.Add(header.Number, big.NewInt(1))
 		}
+
+		ht.missed(expected, batchEnd)
 	}
 
 	return nil
 }
 
-// Loop ...
-func (ht *HeaderTraversal) Loop(ctx context.Context, consumer chan core.Event) error {
-	ticker := time.NewTicker(1 * time.Second)
+// emit ... Sends a header to the consumer, recording any blocks skipped
+// since the last emitted header as missed
+func (ht *HeaderTraversal) emit(header types.Header, consumer chan core.Event) {
+	if tip := ht.tip(); tip != nil {
+		expected := new(big.Int).Add(tip.Number, big.NewInt(1))
+		if header.Number.Cmp(expected) > 0 {
+			ht.missed(expected, new(big.Int).Sub(header.Number, big.NewInt(1)))
+		}
+	}
 
-	recent, err := ht.client.BlockHeaderByNumber(nil)
-	if err != nil {
-		logging.WithContext(ctx).Error("Failed to get latest header", zap.Error(err))
+	ht.publish(header, consumer)
+}
+
+// publish ... Tracks a header and sends it to the consumer
+func (ht *HeaderTraversal) publish(header types.Header, consumer chan core.Event) {
+	ht.track(header)
+	consumer <- core.Event{
+		Network:   ht.n,
+		Timestamp: time.Now(),
+		Type:      core.BlockHeader,
+		BlockHash: header.Hash(),
+		Value:     header,
 	}
+}
 
-	// backfill if provided starting header
-	if ht.traversal.LastHeader() != nil {
-		err = ht.Backfill(ht.traversal.LastHeader().Number, recent.Number, consumer)
-		if err != nil {
-			return err
+// Loop ... Polls for new headers on the configured poll interval
+func (ht *HeaderTraversal) Loop(ctx context.Context, consumer chan core.Event) error {
+	ticker := time.NewTicker(ht.interval())
+	defer ticker.Stop()
+
+	if err := ht.start(ctx, ticker, consumer); err != nil {
+		if ctx.Err() != nil { // Shutdown before the traversal started
+			return nil
 		}
-	} else {
-		ht.traversal = ix_node.NewHeaderTraversal(ht.client, recent, big.NewInt(0))
+
+		return err
 	}
 
 	for {
 		select {
 		case <-ticker.C:
+			ht.poll(ctx, consumer)
 
-			header, err := ht.client.BlockHeaderByNumber(nil)
-			if err != nil {
-				return err
-			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
 
-			if header.Number.Cmp(ht.traversal.LastHeader().Number) > 0 {
-				headers, err := ht.traversal.NextFinalizedHeaders(batchSize)
-				if err != nil {
-					return err
-				}
-
-				for _, header := range headers {
-					consumer <- core.Event{
-						Network:   ht.n,
-						Timestamp: time.Now(),
-						Type:      core.BlockHeader,
-						Value:     header,
-					}
-				}
-			}
+// interval ... Returns the configured poll interval or the default if none is set
+func (ht *HeaderTraversal) interval() time.Duration {
+	if ht.pollInterval <= 0 {
+		return defaultPollInterval
+	}
+
+	return ht.pollInterval
+}
+
+// start ... Positions the traversal at its starting header, backfilling from either
+// the configured start or the path's checkpoint up to the most recent confirmed header.
+// Failures to fetch the most recent header are retried on every tick
+func (ht *HeaderTraversal) start(ctx context.Context, ticker *time.Ticker,
+	consumer chan core.Event) error {
+	logger := logging.WithContext(ctx)
+
+	// Retry until the starting header can be fetched
+	recent, err := ht.confirmedHeader()
+	for err != nil {
+		logger.Error("Failed to get latest header; retrying",
+			zap.String(logging.Path, ht.pathID.String()),
+			zap.Error(err))
+
+		select {
+		case <-ticker.C:
+			recent, err = ht.confirmedHeader()
 
 		case <-ctx.Done():
-			return nil
+			return ctx.Err()
+		}
+	}
+
+	// Resume from the path's checkpoint if no starting header was provided
+	if ht.traversal.LastHeader() == nil && ht.ck != nil {
+		ht.resume(ctx, recent)
+	}
+
+	// backfill if provided starting header
+	if ht.traversal.LastHeader() == nil {
+		ht.track(*recent)
+		ht.traversal = ix_node.NewHeaderTraversal(ht.client, recent, ht.confDepth)
+		return nil
+	}
+
+	start := ht.traversal.LastHeader().Number
+	if err := ht.Backfill(start, recent.Number, consumer); err != nil {
+		return err
+	}
+
+	// Resume traversal from the latest header since every backfilled
+	// block was either emitted or recorded as missed
+	ht.rewind(*recent)
+	return nil
+}
+
+// poll ... Fetches the chain head and traverses any new headers.
+// RPC failures are logged and retried on the next poll
+func (ht *HeaderTraversal) poll(ctx context.Context, consumer chan core.Event) {
+	header, err := ht.client.BlockHeaderByNumber(nil)
+	if err != nil {
+		logging.WithContext(ctx).Warn("Failed to get latest header",
+			zap.String(logging.Path, ht.pathID.String()),
+			zap.Error(err))
+		return
+	}
+
+	ht.head.Store(header.Number)
+	ht.catchUp(ctx, consumer)
+}
+
+// catchUp ... Traverses headers in batches until the traversal reaches the chain head
+func (ht *HeaderTraversal) catchUp(ctx context.Context, consumer chan core.Event) {
+	for {
+		last := ht.traversal.LastHeader().Number
+		if head := ht.head.Load(); head != nil && head.Cmp(last) <= 0 {
+			return
 		}
+
+		if err := ht.next(ctx, consumer); err != nil {
+			logging.WithContext(ctx).Warn("Failed to traverse headers",
+				zap.String(logging.Path, ht.pathID.String()),
+				zap.Error(err))
+			return
+		}
+
+		// A partial batch means that the traversal has caught up with the
+		// most recent confirmed header
+		traversed := new(big.Int).Sub(ht.traversal.LastHeader().Number, last)
+		if traversed.Cmp(big.NewInt(batchSize)) < 0 {
+			return
+		}
+	}
+}
+
+// resume ... Positions the traversal at the header following the path's last fully
+// processed block. Blocks that can't be backfilled are recorded as missed
+func (ht *HeaderTraversal) resume(ctx context.Context, recent *types.Header) {
+	height, err := state.GetCheckpoint(ctx, ht.ck)
+	if err != nil { // Path has no checkpoint
+		return
+	}
+
+	start := new(big.Int).Add(height, big.NewInt(1))
+	if start.Cmp(recent.Number) > 0 {
+		return
+	}
+
+	header, err := ht.client.BlockHeaderByNumber(start)
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to get checkpoint header; skipping to latest header",
+			zap.String(logging.Path, ht.pathID.String()),
+			zap.String("checkpoint", height.String()),
+			zap.Error(err))
+
+		ht.missed(start, recent.Number)
+		return
 	}
+
+	logging.WithContext(ctx).Info("Resuming path from checkpoint",
+		zap.String(logging.Path, ht.pathID.String()),
+		zap.String("checkpoint", height.String()))
+
+	ht.traversal = ix_node.NewHeaderTraversal(ht.client, header, ht.confDepth)
+}
+
+// missed ... Records all blocks in the inclusive height range as missed
+func (ht *HeaderTraversal) missed(start, end *big.Int) {
+	count := new(big.Int).Sub(end, start)
+	if count.Sign() < 0 {
+		return
+	}
+
+	ht.stats.AddMissedBlocks(ht.pathID, int(count.Int64()+1))
+}
+
+// confirmedHeader ... Returns the most recent header that is buried by at least
+// the configured confirmation depth
+func (ht *HeaderTraversal) confirmedHeader() (*types.Header, error) {
+	latest, err := ht.client.BlockHeaderByNumber(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ht.head.Store(latest.Number)
+	if ht.confDepth.Sign() == 0 {
+		return latest, nil
+	}
+
+	height := new(big.Int).Sub(latest.Number, ht.confDepth)
+	if height.Sign() < 0 {
+		height = big.NewInt(0)
+	}
+
+	return ht.client.BlockHeaderByNumber(height)
+}
+
+// next ... Fetches and emits the next batch of headers, handling any reorg
+// that is detected along the way
+func (ht *HeaderTraversal) next(ctx context.Context, consumer chan core.Event) error {
+	headers, err := ht.traversal.NextFinalizedHeaders(batchSize)
+	if errors.Is(err, ix_node.ErrHeaderTraversalAndProviderMismatchedState) {
+		return ht.handleReorg(ctx, consumer)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !ht.emitBatch(headers, consumer) {
+		return ht.handleReorg(ctx, consumer)
+	}
+
+	return nil
+}
+
+// emitBatch ... Emits headers in order as long as each one builds on top of the
+// last emitted header. Returns false if a header with an unknown parent is found,
+// in which case the traversal is rewound to the last emitted header
+func (ht *HeaderTraversal) emitBatch(headers []types.Header, consumer chan core.Event) bool {
+	for _, header := range headers {
+		if tip := ht.tip(); tip != nil && header.ParentHash != tip.Hash() {
+			ht.rewind(*tip)
+			return false
+		}
+
+		ht.emit(header, consumer)
+	}
+
+	return true
+}
+
+// handleReorg ... Locates the most recent common ancestor between the emitted
+// headers and the canonical chain, notifies downstream consumers of the orphaned
+// blocks and re-emits the canonical blocks from the ancestor onwards
+func (ht *HeaderTraversal) handleReorg(ctx context.Context, consumer chan core.Event) error {
+	logger := logging.WithContext(ctx)
+
+	ancestor, orphaned, err := ht.findAncestor()
+	if err != nil {
+		return err
+	}
+
+	logger.Warn("Detected chain reorganization",
+		zap.String(logging.Path, ht.pathID.String()),
+		zap.String("ancestor", ancestor.Hash().String()),
+		zap.Int("depth", len(orphaned)))
+
+	hashes := make([]common.Hash, len(orphaned))
+	for i, header := range orphaned {
+		hashes[i] = header.Hash()
+	}
+
+	ht.rewind(*ancestor)
+
+	if len(hashes) > 0 {
+		consumer <- core.NewEvent(core.Reorg, core.ChainReorg{
+			Network:        ht.n,
+			AncestorHash:   ancestor.Hash(),
+			AncestorHeight: ancestor.Number,
+			Orphaned:       hashes,
+		}, core.WithNetwork(ht.n))
+	}
+
+	headers, err := ht.traversal.NextFinalizedHeaders(batchSize)
+	if err != nil {
+		// Remaining canonical blocks will be picked up on the next poll
+		logger.Error("Failed to re-emit canonical headers after reorg",
+			zap.String(logging.Path, ht.pathID.String()),
+			zap.Error(err))
+		return nil
+	}
+
+	_ = ht.emitBatch(headers, consumer)
+	return nil
+}
+
+// findAncestor ... Walks the window of recently emitted headers backwards until
+// a header that is still canonical is found. Returns the ancestor and the
+// orphaned headers that were emitted after it
+func (ht *HeaderTraversal) findAncestor() (*types.Header, []types.Header, error) {
+	for i := len(ht.recent) - 1; i >= 0; i-- {
+		canonical, err := ht.client.BlockHeaderByNumber(ht.recent[i].Number)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if canonical.Hash() == ht.recent[i].Hash() {
+			return canonical, ht.recent[i+1:], nil
+		}
+	}
+
+	if len(ht.recent) == 0 {
+		return nil, nil, fmt.Errorf(reorgWindowErr)
+	}
+
+	// Reorg is deeper than the tracked window; keep walking back through the
+	// previously emitted headers by parent hash until a canonical one is found
+	orphaned := ht.recent
+	for parent := ht.recent[0].ParentHash; ; {
+		emitted, err := ht.client.BlockHeaderByHash(parent)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", reorgAncestorErr, err)
+		}
+
+		canonical, err := ht.client.BlockHeaderByNumber(emitted.Number)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if canonical.Hash() == emitted.Hash() {
+			return canonical, orphaned, nil
+		}
+
+		if emitted.Number.Sign() == 0 {
+			return nil, nil, fmt.Errorf(reorgAncestorErr)
+		}
+
+		orphaned = append([]types.Header{*emitted}, orphaned...)
+		parent = emitted.ParentHash
+	}
+}
+
+// tip ... Returns the most recently tracked header
+func (ht *HeaderTraversal) tip() *types.Header {
+	if len(ht.recent) == 0 {
+		return nil
+	}
+
+	return &ht.recent[len(ht.recent)-1]
+}
+
+// track ... Adds a header to the reorg detection window
+func (ht *HeaderTraversal) track(header types.Header) {
+	ht.recent = append(ht.recent, header)
+	if len(ht.recent) > reorgWindow {
+		ht.recent = ht.recent[len(ht.recent)-reorgWindow:]
+	}
+
+	ht.height.Store(new(big.Int).Set(header.Number))
+}
+
+// rewind ... Resets the traversal and reorg detection window to the provided header
+func (ht *HeaderTraversal) rewind(header types.Header) {
+	for i := len(ht.recent) - 1; i >= 0; i-- {
+		if ht.recent[i].Number.Cmp(header.Number) < 0 {
+			break
+		}
+
+		ht.recent = ht.recent[:i]
+	}
+
+	ht.track(header)
+	ht.traversal = ix_node.NewHeaderTraversal(ht.client, &header, ht.confDepth)
 }
@@ -0,0 +1,502 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testChain ... Mocked chain whose canonical headers can be swapped to simulate a reorg
+type testChain struct {
+	headers map[uint64]*types.Header
+	seen    map[common.Hash]*types.Header
+	latest  uint64
+
+	*sync.Mutex
+}
+
+func (tc *testChain) set(headers ...*types.Header) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.seen == nil {
+		tc.seen = make(map[common.Hash]*types.Header)
+	}
+
+	for _, h := range headers {
+		tc.headers[h.Number.Uint64()] = h
+		tc.seen[h.Hash()] = h
+		if h.Number.Uint64() > tc.latest {
+			tc.latest = h.Number.Uint64()
+		}
+	}
+}
+
+func (tc *testChain) byNumber(n *big.Int) (*types.Header, error) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	if n == nil {
+		return tc.headers[tc.latest], nil
+	}
+
+	return tc.headers[n.Uint64()], nil
+}
+
+func (tc *testChain) byHash(hash common.Hash) (*types.Header, error) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	h, ok := tc.seen[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return h, nil
+}
+
+func (tc *testChain) byRange(start, end *big.Int) ([]types.Header, error) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	headers := make([]types.Header, 0)
+	for i := start.Uint64(); i <= end.Uint64() && i <= tc.latest; i++ {
+		headers = append(headers, *tc.headers[i])
+	}
+
+	return headers, nil
+}
+
+func child(parent *types.Header, extra byte) *types.Header {
+	return &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+		Extra:      []byte{extra},
+	}
+}
+
+func readEvent(t *testing.T, events chan core.Event) core.Event {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reader event")
+	}
+
+	return core.Event{}
+}
+
+// TestHeaderTraversalReorg ... Ensures that reorgs are detected and canonical headers re-emitted
+func TestHeaderTraversalReorg(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, ms := mocks.Context(ctx, gomock.NewController(t))
+
+	genesis := &types.Header{Number: big.NewInt(0)}
+	h1 := child(genesis, 0)
+	h2 := child(h1, 0)
+
+	forkH2 := child(h1, 1)
+	forkH3 := child(forkH2, 1)
+
+	chain := &testChain{
+		headers: make(map[uint64]*types.Header),
+		Mutex:   &sync.Mutex{},
+	}
+	chain.set(genesis, h1, h2)
+
+	ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).DoAndReturn(chain.byNumber).AnyTimes()
+	ms.MockL1Node.EXPECT().BlockHeadersByRange(gomock.Any(), gomock.Any()).DoAndReturn(chain.byRange).AnyTimes()
+
+	p, err := registry.NewHeaderTraversal(ctx, &core.ClientConfig{
+		Network:   core.Layer1,
+		EndHeight: big.NewInt(1),
+	})
+	assert.NoError(t, err)
+
+	events := make(chan core.Event)
+	assert.NoError(t, p.AddSubscriber(core.MakeProcessID(1, 1, 1, 1), events))
+
+	go func() {
+		_ = p.EventLoop()
+	}()
+
+	// 1. Backfill from the starting header to the latest header
+	for _, h := range []*types.Header{h1, h2} {
+		e := readEvent(t, events)
+		assert.Equal(t, core.BlockHeader, e.Type)
+		assert.Equal(t, h.Hash(), e.BlockHash)
+	}
+
+	// 2. Reorg out h2 and ensure a notification is emitted followed by canonical headers
+	chain.set(forkH2, forkH3)
+
+	e := readEvent(t, events)
+	assert.Equal(t, core.Reorg, e.Type)
+	assert.Equal(t, core.Layer1, e.Network)
+
+	reorg, ok := e.Value.(core.ChainReorg)
+	assert.True(t, ok)
+	assert.Equal(t, h1.Hash(), reorg.AncestorHash)
+	assert.Equal(t, 1, reorg.Depth())
+	assert.True(t, reorg.IsOrphaned(h2.Hash()))
+
+	e = readEvent(t, events)
+	assert.Equal(t, forkH2.Hash(), e.BlockHash)
+
+	e = readEvent(t, events)
+	assert.Equal(t, forkH3.Hash(), e.BlockHash)
+}
+
+// TestHeaderTraversalDeepReorg ... Ensures that the common ancestor of a reorg deeper
+// than the tracked window is found by walking back through the emitted headers
+func TestHeaderTraversalDeepReorg(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, ms := mocks.Context(ctx, gomock.NewController(t))
+
+	genesis := &types.Header{Number: big.NewInt(0)}
+	h1 := child(genesis, 0)
+
+	chain := &testChain{
+		headers: make(map[uint64]*types.Header),
+		Mutex:   &sync.Mutex{},
+	}
+	chain.set(genesis, h1)
+
+	emitted := make([]*types.Header, 0)
+	for h := h1; len(emitted) < 70; h = emitted[len(emitted)-1] {
+		emitted = append(emitted, child(h, 0))
+	}
+	chain.set(emitted...)
+
+	ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).DoAndReturn(chain.byNumber).AnyTimes()
+	ms.MockL1Node.EXPECT().BlockHeaderByHash(gomock.Any()).DoAndReturn(chain.byHash).AnyTimes()
+	ms.MockL1Node.EXPECT().BlockHeadersByRange(gomock.Any(), gomock.Any()).DoAndReturn(chain.byRange).AnyTimes()
+
+	p, err := registry.NewHeaderTraversal(ctx, &core.ClientConfig{
+		Network:   core.Layer1,
+		EndHeight: big.NewInt(1),
+	})
+	assert.NoError(t, err)
+
+	events := make(chan core.Event)
+	assert.NoError(t, p.AddSubscriber(core.MakeProcessID(1, 1, 1, 1), events))
+
+	go func() {
+		_ = p.EventLoop()
+	}()
+
+	// 1. Backfill past the tracked window
+	for _, h := range append([]*types.Header{h1}, emitted...) {
+		e := readEvent(t, events)
+		assert.Equal(t, h.Hash(), e.BlockHash)
+	}
+
+	// 2. Reorg out every header after h1
+	fork := make([]*types.Header, 0)
+	for h := h1; len(fork) < 71; h = fork[len(fork)-1] {
+		fork = append(fork, child(h, 1))
+	}
+	chain.set(fork...)
+
+	e := readEvent(t, events)
+	assert.Equal(t, core.Reorg, e.Type)
+
+	reorg, ok := e.Value.(core.ChainReorg)
+	assert.True(t, ok)
+	assert.Equal(t, h1.Hash(), reorg.AncestorHash)
+	assert.Equal(t, len(emitted), reorg.Depth())
+	assert.True(t, reorg.IsOrphaned(emitted[0].Hash()))
+
+	e = readEvent(t, events)
+	assert.Equal(t, fork[0].Hash(), e.BlockHash)
+}
+
+// TestHeaderTraversalRetry ... Ensures that transient RPC failures are retried rather than ending traversal
+func TestHeaderTraversalRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, ms := mocks.Context(ctx, gomock.NewController(t))
+
+	genesis := &types.Header{Number: big.NewInt(0)}
+	h1 := child(genesis, 0)
+	h2 := child(h1, 0)
+	h3 := child(h2, 0)
+
+	chain := &testChain{
+		headers: make(map[uint64]*types.Header),
+		Mutex:   &sync.Mutex{},
+	}
+	chain.set(genesis, h1, h2)
+
+	var failing atomic.Bool
+
+	ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).
+		DoAndReturn(func(n *big.Int) (*types.Header, error) {
+			if failing.Load() {
+				return nil, fmt.Errorf("connection refused")
+			}
+
+			return chain.byNumber(n)
+		}).AnyTimes()
+	ms.MockL1Node.EXPECT().BlockHeadersByRange(gomock.Any(), gomock.Any()).DoAndReturn(chain.byRange).AnyTimes()
+
+	p, err := registry.NewHeaderTraversal(ctx, &core.ClientConfig{
+		Network:   core.Layer1,
+		EndHeight: big.NewInt(1),
+	})
+	assert.NoError(t, err)
+
+	events := make(chan core.Event)
+	assert.NoError(t, p.AddSubscriber(core.MakeProcessID(1, 1, 1, 1), events))
+
+	failing.Store(true)
+	go func() {
+		_ = p.EventLoop()
+	}()
+
+	// 1. Recover once the starting header can be fetched
+	time.Sleep(1500 * time.Millisecond)
+	failing.Store(false)
+
+	for _, h := range []*types.Header{h1, h2} {
+		e := readEvent(t, events)
+		assert.Equal(t, h.Hash(), e.BlockHash)
+	}
+
+	// 2. Recover from failures while polling for new headers
+	failing.Store(true)
+	chain.set(h3)
+	time.Sleep(1500 * time.Millisecond)
+	failing.Store(false)
+
+	e := readEvent(t, events)
+	assert.Equal(t, h3.Hash(), e.BlockHash)
+
+	reader, ok := p.(*process.ChainReader)
+	assert.True(t, ok)
+
+	assert.Eventually(t, func() bool {
+		lag, err := reader.Lag()
+		return err == nil && lag.Sign() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// testSubscription ... Head subscription that can be dropped on demand
+type testSubscription struct {
+	errs chan error
+}
+
+func (ts *testSubscription) Unsubscribe() {}
+
+func (ts *testSubscription) Err() <-chan error {
+	return ts.errs
+}
+
+// TestHeadSubscription ... Ensures that announced heads are traversed without waiting on the poll
+// interval and that blocks mined while the subscription is down are traversed once reconnected
+func TestHeadSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	ctx, ms := mocks.Context(ctx, ctrl)
+
+	genesis := &types.Header{Number: big.NewInt(0)}
+	h1 := child(genesis, 0)
+	h2 := child(h1, 0)
+	h3 := child(h2, 0)
+	h4 := child(h3, 0)
+	h5 := child(h4, 0)
+
+	chain := &testChain{
+		headers: make(map[uint64]*types.Header),
+		Mutex:   &sync.Mutex{},
+	}
+	chain.set(genesis, h1, h2)
+
+	ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).DoAndReturn(chain.byNumber).AnyTimes()
+	ms.MockL1Node.EXPECT().BlockHeadersByRange(gomock.Any(), gomock.Any()).DoAndReturn(chain.byRange).AnyTimes()
+
+	subscriber := mocks.NewMockHeadSubscriber(ctrl)
+	ms.Bundle.L1Subscriber = subscriber
+
+	subs := make(chan chan<- *types.Header, 2)
+	sub := &testSubscription{errs: make(chan error, 1)}
+	subscriber.EXPECT().SubscribeNewHead(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+			subs <- ch
+			return sub, nil
+		}).Times(2)
+
+	// Poll interval is long enough to ensure that headers are only traversed when announced
+	p, err := registry.NewHeaderTraversal(ctx, &core.ClientConfig{
+		Network:      core.Layer1,
+		EndHeight:    big.NewInt(1),
+		PollInterval: time.Hour,
+	})
+	assert.NoError(t, err)
+
+	events := make(chan core.Event)
+	assert.NoError(t, p.AddSubscriber(core.MakeProcessID(1, 1, 1, 1), events))
+
+	go func() {
+		_ = p.EventLoop()
+	}()
+
+	for _, h := range []*types.Header{h1, h2} {
+		e := readEvent(t, events)
+		assert.Equal(t, h.Hash(), e.BlockHash)
+	}
+
+	// 1. Traverse announced heads
+	heads := <-subs
+	chain.set(h3)
+	heads <- h3
+
+	e := readEvent(t, events)
+	assert.Equal(t, h3.Hash(), e.BlockHash)
+
+	// 2. Traverse headers mined while disconnected once the subscription is re-established
+	chain.set(h4, h5)
+	sub.errs <- fmt.Errorf("connection reset")
+	<-subs
+
+	for _, h := range []*types.Header{h4, h5} {
+		e := readEvent(t, events)
+		assert.Equal(t, h.Hash(), e.BlockHash)
+	}
+}
+
+// missedCounter ... Metricer that counts missed blocks
+type missedCounter struct {
+	metrics.Metricer
+	count int
+
+	*sync.Mutex
+}
+
+func (mc *missedCounter) AddMissedBlocks(_ core.PathID, n int) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	mc.count += n
+}
+
+func (mc *missedCounter) missed() int {
+	mc.Lock()
+	defer mc.Unlock()
+
+	return mc.count
+}
+
+// TestHeaderTraversalResume ... Ensures that paths resume from their last checkpoint
+func TestHeaderTraversalResume(t *testing.T) {
+	headers := []*types.Header{{Number: big.NewInt(0)}}
+	for i := 1; i <= 5; i++ {
+		headers = append(headers, child(headers[i-1], 0))
+	}
+
+	var tests = []struct {
+		name     string
+		rangeErr error
+		// omitted ... Number of trailing headers omitted from range responses
+		omitted    int
+		expected   []*types.Header
+		missedBlks int
+	}{
+		{
+			name:     "Backfill from checkpoint",
+			expected: headers[3:],
+		},
+		{
+			name:       "Record missed blocks when backfill fails",
+			rangeErr:   fmt.Errorf("pruned"),
+			missedBlks: 3,
+		},
+		{
+			name:       "Record missed blocks omitted by the provider",
+			omitted:    1,
+			expected:   headers[3:5],
+			missedBlks: 1,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stats := &missedCounter{Metricer: metrics.NoopMetrics, Mutex: &sync.Mutex{}}
+			ctx = context.WithValue(ctx, core.Metrics, stats)
+			ctx, ms := mocks.Context(ctx, gomock.NewController(t))
+
+			chain := &testChain{
+				headers: make(map[uint64]*types.Header),
+				Mutex:   &sync.Mutex{},
+			}
+			chain.set(headers...)
+
+			ms.MockL1Node.EXPECT().BlockHeaderByNumber(gomock.Any()).DoAndReturn(chain.byNumber).AnyTimes()
+			ms.MockL1Node.EXPECT().BlockHeadersByRange(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(start, end *big.Int) ([]types.Header, error) {
+					if test.rangeErr != nil {
+						return nil, test.rangeErr
+					}
+
+					resp, err := chain.byRange(start, end)
+					if err != nil {
+						return nil, err
+					}
+
+					return resp[:len(resp)-test.omitted], nil
+				}).AnyTimes()
+
+			// 1. Record block 2 as the last fully processed block
+			ck := core.MakeCheckpointKey(core.PathIdentifier{}, 0)
+			assert.NoError(t, state.SetCheckpoint(ctx, ck, big.NewInt(2)))
+
+			p, err := registry.NewHeaderTraversal(ctx, &core.ClientConfig{
+				Network: core.Layer1,
+			}, process.WithCheckpointKey(ck))
+			assert.NoError(t, err)
+
+			events := make(chan core.Event)
+			assert.NoError(t, p.AddSubscriber(core.MakeProcessID(1, 1, 1, 1), events))
+
+			go func() {
+				_ = p.EventLoop()
+			}()
+
+			// 2. Ensure that the blocks after the checkpoint are emitted or recorded as missed
+			for _, h := range test.expected {
+				e := readEvent(t, events)
+				assert.Equal(t, h.Hash(), e.BlockHash)
+			}
+
+			assert.Eventually(t, func() bool {
+				return stats.missed() == test.missedBlks
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// ReceiptSubscription ... Subscription that emits the receipt of every
+// transaction emitted by an upstream transaction process
+type ReceiptSubscription struct {
+	PathID core.PathID
+
+	client client.EthClient
+	// last ... Most recently fetched receipt. Transactions involving multiple monitored
+	// addresses are emitted consecutively, so their receipt is only fetched once
+	last *types.Receipt
+}
+
+// NewReceiptSubscription ... Initializer
+func NewReceiptSubscription(ctx context.Context, n core.Network) (*ReceiptSubscription, error) {
+	client, err := client.FromNetwork(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReceiptSubscription{
+		client: client,
+	}, nil
+}
+
+// NewReceiptSubscriber ... Process initializer
+func NewReceiptSubscriber(ctx context.Context, cfg *core.ClientConfig,
+	opts ...process.Option) (process.Process, error) {
+	s, err := NewReceiptSubscription(ctx, cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := process.NewSubscriber(ctx, s, core.Transaction, core.Receipt, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.PathID = p.PathID()
+	return p, nil
+}
+
+// Run ... Fetches the receipt of a transaction. The receipt event keeps the address
+// of the transaction event so that it's routed to the same sessions
+func (sub *ReceiptSubscription) Run(ctx context.Context, e core.Event) ([]core.Event, error) {
+	tx, success := e.Value.(core.Tx)
+	if !success {
+		return []core.Event{}, fmt.Errorf("could not convert to transaction")
+	}
+
+	receipt, err := sub.receipt(ctx, tx.Hash())
+	if err != nil {
+		return []core.Event{}, err
+	}
+
+	data := core.TxReceipt{
+		Receipt: receipt,
+		Tx:      tx,
+	}
+
+	return []core.Event{
+		core.NewEvent(core.Receipt, data, core.WithAddress(e.Address),
+			core.WithOriginTS(e.OriginTS), core.WithBlockHash(e.BlockHash)),
+	}, nil
+}
+
+// receipt ... Returns the receipt of a transaction, reusing the most recently fetched receipt
+func (sub *ReceiptSubscription) receipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if sub.last != nil && sub.last.TxHash == hash {
+		return sub.last, nil
+	}
+
+	receipt, err := retry.Do[*types.Receipt](ctx, 10, core.RetryStrategy(), func() (*types.Receipt, error) {
+		return sub.client.TransactionReceipt(context.Background(), hash)
+	})
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to fetch transaction receipt",
+			zap.String(logging.Path, sub.PathID.String()),
+			zap.String("tx", hash.String()),
+			zap.Error(err))
+		return nil, err
+	}
+
+	sub.last = receipt
+	return receipt, nil
+}
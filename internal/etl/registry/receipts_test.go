@@ -0,0 +1,100 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiptSubscription(t *testing.T) {
+	to := common.HexToAddress("0x420")
+	from := common.HexToAddress("0x421")
+	blockHash := common.HexToHash("0x69")
+
+	tx := core.Tx{
+		Transaction: types.NewTx(&types.LegacyTx{
+			To:       &to,
+			Value:    big.NewInt(1),
+			Gas:      21_000,
+			GasPrice: big.NewInt(1),
+		}),
+		From:        from,
+		BlockHash:   blockHash,
+		BlockNumber: big.NewInt(10),
+	}
+
+	var tests = []struct {
+		name       string
+		receiptErr error
+		status     uint64
+	}{
+		{
+			name:       "Error when failing to fetch receipt",
+			receiptErr: fmt.Errorf("not found"),
+		},
+		{
+			name:   "Emit successful receipt",
+			status: types.ReceiptStatusSuccessful,
+		},
+		{
+			name:   "Emit reverted receipt",
+			status: types.ReceiptStatusFailed,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			sub, err := registry.NewReceiptSubscription(ctx, core.Layer1)
+			assert.NoError(t, err)
+
+			if test.receiptErr != nil {
+				ms.MockL1.EXPECT().TransactionReceipt(gomock.Any(), tx.Hash()).
+					Return(nil, test.receiptErr).Times(10)
+			} else {
+				ms.MockL1.EXPECT().TransactionReceipt(gomock.Any(), tx.Hash()).
+					Return(&types.Receipt{
+						Status:            test.status,
+						TxHash:            tx.Hash(),
+						GasUsed:           21_000,
+						EffectiveGasPrice: big.NewInt(2),
+					}, nil).Times(1)
+			}
+
+			events, err := sub.Run(ctx, core.Event{Value: tx, Address: from, BlockHash: blockHash})
+			if test.receiptErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, events, 1)
+			assert.Equal(t, core.Receipt, events[0].Type)
+			assert.Equal(t, from, events[0].Address)
+			assert.Equal(t, blockHash, events[0].BlockHash)
+
+			receipt, ok := events[0].Value.(core.TxReceipt)
+			assert.True(t, ok)
+			assert.Equal(t, test.status == types.ReceiptStatusFailed, receipt.Reverted())
+			assert.Equal(t, uint64(21_000), receipt.GasUsed)
+			assert.Equal(t, big.NewInt(2), receipt.EffectiveGasPrice)
+			assert.Equal(t, tx.Hash(), receipt.Tx.Hash())
+
+			// The receipt is reused for the recipient's event of the same transaction
+			events, err = sub.Run(ctx, core.Event{Value: tx, Address: to, BlockHash: blockHash})
+			assert.NoError(t, err)
+			assert.Len(t, events, 1)
+			assert.Equal(t, to, events[0].Address)
+		})
+	}
+}
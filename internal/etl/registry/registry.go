@@ -40,6 +40,68 @@ func New() *Registry {
 				PathID:  nil,
 			},
 		},
+
+		core.Transaction: {
+			Addressing:  true,
+			DataType:    core.Transaction,
+			ProcessType: core.Subscribe,
+			Constructor: NewTxSubscriber,
+
+			Dependencies: makeDeps(core.BlockHeader),
+			Sk: &core.StateKey{
+				Nesting: false,
+				Prefix:  core.Transaction,
+				ID:      core.AddressKey,
+				PathID:  nil,
+			},
+		},
+
+		core.Receipt: {
+			Addressing:  true,
+			DataType:    core.Receipt,
+			ProcessType: core.Subscribe,
+			Constructor: NewReceiptSubscriber,
+
+			Dependencies: makeDeps(core.Transaction, core.BlockHeader),
+			// Addresses are filtered by the upstream transaction process,
+			// which shares the path's transaction state key
+			Sk: &core.StateKey{
+				Nesting: false,
+				Prefix:  core.Transaction,
+				ID:      core.AddressKey,
+				PathID:  nil,
+			},
+		},
+
+		core.Trace: {
+			Addressing:  true,
+			DataType:    core.Trace,
+			ProcessType: core.Subscribe,
+			Constructor: NewTraceSubscriber,
+
+			Dependencies: makeDeps(core.BlockHeader),
+			Sk: &core.StateKey{
+				Nesting: false,
+				Prefix:  core.Trace,
+				ID:      core.AddressKey,
+				PathID:  nil,
+			},
+		},
+
+		core.StorageSlot: {
+			Addressing:  true,
+			DataType:    core.StorageSlot,
+			ProcessType: core.Subscribe,
+			Constructor: NewSlotSubscriber,
+
+			Dependencies: makeDeps(core.BlockHeader),
+			Sk: &core.StateKey{
+				Nesting: true,
+				Prefix:  core.StorageSlot,
+				ID:      core.AddressKey,
+				PathID:  nil,
+			},
+		},
 	}
 
 	return &Registry{topics}
@@ -0,0 +1,137 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// SlotSubscription ... Subscription that reads the storage slots of every
+// address in the state store at each block
+type SlotSubscription struct {
+	PathID core.PathID
+	SK     *core.StateKey
+
+	client client.EthClient
+	ss     state.Store
+}
+
+// NewSlotSubscription ... Initializer
+func NewSlotSubscription(ctx context.Context, n core.Network) (*SlotSubscription, error) {
+	client, err := client.FromNetwork(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, err := state.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SlotSubscription{
+		client: client,
+		ss:     ss,
+	}, nil
+}
+
+// NewSlotSubscriber ... Process initializer
+func NewSlotSubscriber(ctx context.Context, cfg *core.ClientConfig,
+	opts ...process.Option) (process.Process, error) {
+	s, err := NewSlotSubscription(ctx, cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := process.NewSubscriber(ctx, s, core.BlockHeader, core.StorageSlot, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SK = p.StateKey().Clone()
+	s.PathID = p.PathID()
+	return p, nil
+}
+
+// getSlots ... Returns the storage slots to read for an address
+func (sub *SlotSubscription) getSlots(ctx context.Context, address string) ([]common.Hash, error) {
+	innerKey := &core.StateKey{
+		Nesting: false,
+		Prefix:  sub.SK.Prefix,
+		ID:      address,
+		PathID:  sub.SK.PathID,
+	}
+
+	entries, err := sub.ss.GetSlice(ctx, innerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]common.Hash, 0, len(entries))
+	for _, entry := range entries {
+		slots = append(slots, common.HexToHash(entry))
+	}
+
+	return slots, nil
+}
+
+// Run ... Reads every monitored storage slot at the block of a header
+func (sub *SlotSubscription) Run(ctx context.Context, e core.Event) ([]core.Event, error) {
+	header, success := e.Value.(types.Header)
+	if !success {
+		return []core.Event{}, fmt.Errorf("could not convert to header")
+	}
+
+	addresses, err := sub.ss.GetSlice(ctx, sub.SK)
+	if err != nil {
+		return []core.Event{}, err
+	}
+
+	hash := header.Hash()
+	result := make([]core.Event, 0)
+	for _, address := range addresses {
+		slots, err := sub.getSlots(ctx, address)
+		if err != nil {
+			logging.WithContext(ctx).Error("Failed to get storage slots to monitor",
+				zap.String(logging.Path, sub.PathID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		addr := common.HexToAddress(address)
+		for _, slot := range slots {
+			value, err := retry.Do[[]byte](ctx, 10, core.RetryStrategy(), func() ([]byte, error) {
+				return sub.client.StorageAt(ctx, addr, slot, header.Number)
+			})
+			if err != nil {
+				logging.WithContext(ctx).Error("Failed to read storage slot",
+					zap.String(logging.Path, sub.PathID.String()),
+					zap.String(logging.AddrKey, address),
+					zap.String("slot", slot.String()),
+					zap.Error(err))
+				return []core.Event{}, err
+			}
+
+			data := core.SlotValue{
+				Address:     addr,
+				Slot:        slot,
+				Value:       common.BytesToHash(value),
+				BlockNumber: header.Number,
+			}
+
+			result = append(result,
+				core.NewEvent(core.StorageSlot, data, core.WithAddress(addr),
+					core.WithOriginTS(e.OriginTS), core.WithBlockHash(hash)))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotSubscription(t *testing.T) {
+	addr := common.HexToAddress("0x420")
+	slots := []common.Hash{common.HexToHash("0x0"), common.HexToHash("0x1")}
+	header := &types.Header{Number: big.NewInt(10)}
+
+	var tests = []struct {
+		name       string
+		storageErr error
+	}{
+		{
+			name:       "Error when failing to read storage",
+			storageErr: fmt.Errorf("missing trie node"),
+		},
+		{
+			name: "Emit the value of every monitored slot",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			sk := &core.StateKey{Nesting: true, Prefix: core.StorageSlot, ID: core.AddressKey}
+			assert.NoError(t, state.InsertUnique(ctx, sk, addr.String()))
+
+			innerKey := &core.StateKey{Prefix: core.StorageSlot, ID: addr.String()}
+			for _, slot := range slots {
+				assert.NoError(t, state.InsertUnique(ctx, innerKey, slot.Hex()))
+			}
+
+			sub, err := registry.NewSlotSubscription(ctx, core.Layer1)
+			assert.NoError(t, err)
+			sub.SK = sk
+
+			if test.storageErr != nil {
+				ms.MockL1.EXPECT().StorageAt(gomock.Any(), addr, slots[0], header.Number).
+					Return(nil, test.storageErr).Times(10)
+			} else {
+				for j, slot := range slots {
+					ms.MockL1.EXPECT().StorageAt(gomock.Any(), addr, slot, header.Number).
+						Return(common.BigToHash(big.NewInt(int64(j+1))).Bytes(), nil).Times(1)
+				}
+			}
+
+			events, err := sub.Run(ctx, core.Event{Value: *header})
+			if test.storageErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, events, len(slots))
+
+			for j, e := range events {
+				assert.Equal(t, core.StorageSlot, e.Type)
+				assert.Equal(t, addr, e.Address)
+				assert.Equal(t, header.Hash(), e.BlockHash)
+
+				sv, ok := e.Value.(core.SlotValue)
+				assert.True(t, ok)
+				assert.Equal(t, slots[j], sv.Slot)
+				assert.Equal(t, common.BigToHash(big.NewInt(int64(j+1))), sv.Value)
+				assert.Equal(t, header.Number, sv.BlockNumber)
+			}
+		})
+	}
+}
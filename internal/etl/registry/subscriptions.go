@@ -151,7 +151,7 @@ func (sub *LogSubscription) transformEvents(ctx context.Context, e core.Event) (
 	for _, log := range logs {
 		result = append(result,
 			core.NewEvent(core.Log, log, core.WithAddress(log.Address),
-				core.WithOriginTS(e.OriginTS)))
+				core.WithOriginTS(e.OriginTS), core.WithBlockHash(log.BlockHash)))
 	}
 
 	return result, nil
@@ -0,0 +1,160 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+const selectorLen = 4
+
+// TraceSubscription ... Subscription that traces every block and emits the call
+// frames that are sent from or to an address in the state store
+type TraceSubscription struct {
+	PathID core.PathID
+	SK     *core.StateKey
+
+	client client.GethClient
+	ss     state.Store
+}
+
+// NewTraceSubscription ... Initializer
+func NewTraceSubscription(ctx context.Context, n core.Network) (*TraceSubscription, error) {
+	client, err := client.GethFromNetwork(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, err := state.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TraceSubscription{
+		client: client,
+		ss:     ss,
+	}, nil
+}
+
+// NewTraceSubscriber ... Process initializer
+func NewTraceSubscriber(ctx context.Context, cfg *core.ClientConfig,
+	opts ...process.Option) (process.Process, error) {
+	s, err := NewTraceSubscription(ctx, cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := process.NewSubscriber(ctx, s, core.BlockHeader, core.Trace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SK = p.StateKey().Clone()
+	s.PathID = p.PathID()
+	return p, nil
+}
+
+// Run ... Traces the block of a header and emits an event for every monitored
+// address a call frame is sent from or to
+func (sub *TraceSubscription) Run(ctx context.Context, e core.Event) ([]core.Event, error) {
+	header, success := e.Value.(types.Header)
+	if !success {
+		return []core.Event{}, fmt.Errorf("could not convert to header")
+	}
+
+	addresses, err := sub.ss.GetSlice(ctx, sub.SK)
+	if err != nil {
+		return []core.Event{}, err
+	}
+
+	monitored := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		monitored[common.HexToAddress(address)] = struct{}{}
+	}
+
+	hash := header.Hash()
+	traces, err := retry.Do[[]core.TxTrace](ctx, 10, core.RetryStrategy(), func() ([]core.TxTrace, error) {
+		return sub.client.TraceBlockByHash(context.Background(), hash)
+	})
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to trace block",
+			zap.String(logging.Path, sub.PathID.String()),
+			zap.Error(err))
+		return []core.Event{}, err
+	}
+
+	result := make([]core.Event, 0)
+	for _, trace := range traces {
+		if trace.Result == nil {
+			logging.WithContext(ctx).Warn("Failed to trace transaction",
+				zap.String(logging.Path, sub.PathID.String()),
+				zap.String("tx", trace.TxHash.String()),
+				zap.String("error", trace.Error))
+			continue
+		}
+
+		for _, call := range flattenFrame(trace.Result, 0) {
+			call.TxHash = trace.TxHash
+			call.BlockHash = hash
+			call.BlockNumber = header.Number
+
+			// Deployments that failed before creating a contract have no callee
+			var to *common.Address
+			if call.To != (common.Address{}) {
+				to = &call.To
+			}
+
+			// Calls are emitted once per monitored party so that they're
+			// routed to the sessions of both the caller and callee
+			for _, addr := range txParties(call.From, to) {
+				if _, found := monitored[addr]; !found {
+					continue
+				}
+
+				result = append(result,
+					core.NewEvent(core.Trace, call, core.WithAddress(addr),
+						core.WithOriginTS(e.OriginTS), core.WithBlockHash(hash)))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// flattenFrame ... Flattens a call frame and its sub-calls into a depth-first ordered slice
+func flattenFrame(frame *core.CallFrame, depth int) []core.CallTrace {
+	call := core.CallTrace{
+		Type:  frame.Type,
+		From:  frame.From,
+		Depth: depth,
+		Error: frame.Error,
+	}
+
+	if frame.To != nil {
+		call.To = *frame.To
+	}
+
+	if frame.Value != nil {
+		call.Value = frame.Value.ToInt()
+	}
+
+	if len(frame.Input) >= selectorLen {
+		call.Selector = frame.Input[:selectorLen]
+	}
+
+	calls := []core.CallTrace{call}
+	for i := range frame.Calls {
+		calls = append(calls, flattenFrame(&frame.Calls[i], depth+1)...)
+	}
+
+	return calls
+}
@@ -0,0 +1,162 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceSubscription(t *testing.T) {
+	eoa := common.HexToAddress("0x420")
+	proxy := common.HexToAddress("0x421")
+	impl := common.HexToAddress("0x422")
+	monitored := common.HexToAddress("0x423")
+	txHash := common.HexToHash("0x69")
+
+	header := &types.Header{Number: big.NewInt(10)}
+
+	// EOA -> proxy -(delegatecall)-> impl -(call w/ value)-> monitored
+	traces := []core.TxTrace{
+		{
+			TxHash: txHash,
+			Result: &core.CallFrame{
+				Type:  "CALL",
+				From:  eoa,
+				To:    &proxy,
+				Value: (*hexutil.Big)(big.NewInt(0)),
+				Input: hexutil.Bytes{0xde, 0xad, 0xbe, 0xef, 0x01},
+				Calls: []core.CallFrame{
+					{
+						Type:  "DELEGATECALL",
+						From:  proxy,
+						To:    &impl,
+						Input: hexutil.Bytes{0xde, 0xad, 0xbe, 0xef, 0x01},
+						Calls: []core.CallFrame{
+							{
+								Type:  "CALL",
+								From:  proxy,
+								To:    &monitored,
+								Value: (*hexutil.Big)(big.NewInt(100)),
+								Error: "execution reverted",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			TxHash: common.HexToHash("0x70"),
+			Error:  "tracing failed",
+		},
+		{
+			// Failed contract deployment without a created address
+			TxHash: common.HexToHash("0x71"),
+			Result: &core.CallFrame{
+				Type:  "CREATE",
+				From:  eoa,
+				Error: "out of gas",
+			},
+		},
+	}
+
+	var tests = []struct {
+		name     string
+		traceErr error
+		watched  []common.Address
+		expected []common.Address
+	}{
+		{
+			name:     "Error when failing to trace block",
+			traceErr: fmt.Errorf("unknown block"),
+			watched:  []common.Address{monitored},
+		},
+		{
+			name:     "Emit internal value transfer to a monitored address",
+			watched:  []common.Address{monitored},
+			expected: []common.Address{monitored},
+		},
+		{
+			name:     "Emit every call frame of a monitored proxy",
+			watched:  []common.Address{proxy},
+			expected: []common.Address{proxy, proxy, proxy},
+		},
+		{
+			name:    "Ignore deployments without a created address",
+			watched: []common.Address{{}},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			sk := &core.StateKey{Prefix: core.Trace, ID: core.AddressKey}
+			for _, addr := range test.watched {
+				assert.NoError(t, state.InsertUnique(ctx, sk, addr.String()))
+			}
+
+			sub, err := registry.NewTraceSubscription(ctx, core.Layer1)
+			assert.NoError(t, err)
+			sub.SK = sk
+
+			if test.traceErr != nil {
+				ms.MockL1Geth.EXPECT().TraceBlockByHash(gomock.Any(), header.Hash()).
+					Return(nil, test.traceErr).Times(10)
+			} else {
+				ms.MockL1Geth.EXPECT().TraceBlockByHash(gomock.Any(), header.Hash()).
+					Return(traces, nil).Times(1)
+			}
+
+			events, err := sub.Run(ctx, core.Event{Value: *header})
+			if test.traceErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, events, len(test.expected))
+			if len(test.expected) == 0 {
+				return
+			}
+
+			for j, e := range events {
+				assert.Equal(t, core.Trace, e.Type)
+				assert.Equal(t, test.expected[j], e.Address)
+				assert.Equal(t, header.Hash(), e.BlockHash)
+
+				call, ok := e.Value.(core.CallTrace)
+				assert.True(t, ok)
+				assert.Equal(t, txHash, call.TxHash)
+				assert.Equal(t, big.NewInt(10), call.BlockNumber)
+			}
+
+			last, ok := events[len(events)-1].Value.(core.CallTrace)
+			assert.True(t, ok)
+			assert.Equal(t, 2, last.Depth)
+			assert.Equal(t, proxy, last.From)
+			assert.Equal(t, monitored, last.To)
+			assert.Equal(t, big.NewInt(100), last.Value)
+			assert.Nil(t, last.Selector)
+			assert.Equal(t, "execution reverted", last.Error)
+
+			if len(events) > 1 {
+				delegate, ok := events[1].Value.(core.CallTrace)
+				assert.True(t, ok)
+				assert.Equal(t, "DELEGATECALL", delegate.Type)
+				assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, delegate.Selector)
+				assert.Nil(t, delegate.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/client"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/logging"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// TxSubscription ... Subscription that emits the transactions of each block
+// that are sent from or to an address in the state store
+type TxSubscription struct {
+	PathID core.PathID
+	SK     *core.StateKey
+
+	client client.EthClient
+	ss     state.Store
+}
+
+// NewTxSubscription ... Initializer
+func NewTxSubscription(ctx context.Context, n core.Network) (*TxSubscription, error) {
+	client, err := client.FromNetwork(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, err := state.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxSubscription{
+		client: client,
+		ss:     ss,
+	}, nil
+}
+
+// NewTxSubscriber ... Process initializer
+func NewTxSubscriber(ctx context.Context, cfg *core.ClientConfig,
+	opts ...process.Option) (process.Process, error) {
+	s, err := NewTxSubscription(ctx, cfg.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := process.NewSubscriber(ctx, s, core.BlockHeader, core.Transaction, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SK = p.StateKey().Clone()
+	s.PathID = p.PathID()
+	return p, nil
+}
+
+// Run ... Fetches the full block of a header and emits an event for every monitored
+// address a transaction is sent from or to
+func (sub *TxSubscription) Run(ctx context.Context, e core.Event) ([]core.Event, error) {
+	header, success := e.Value.(types.Header)
+	if !success {
+		return []core.Event{}, fmt.Errorf("could not convert to header")
+	}
+
+	addresses, err := sub.ss.GetSlice(ctx, sub.SK)
+	if err != nil {
+		return []core.Event{}, err
+	}
+
+	monitored := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		monitored[common.HexToAddress(address)] = struct{}{}
+	}
+
+	hash := header.Hash()
+	block, err := retry.Do[*types.Block](ctx, 10, core.RetryStrategy(), func() (*types.Block, error) {
+		return sub.client.BlockByHash(context.Background(), hash)
+	})
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to fetch block",
+			zap.String(logging.Path, sub.PathID.String()),
+			zap.Error(err))
+		return []core.Event{}, err
+	}
+
+	result := make([]core.Event, 0)
+	for i, tx := range block.Transactions() {
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to recover transaction sender",
+				zap.String(logging.Path, sub.PathID.String()),
+				zap.String("tx", tx.Hash().String()),
+				zap.Error(err))
+			continue
+		}
+
+		data := core.Tx{
+			Transaction: tx,
+			From:        from,
+			BlockHash:   hash,
+			BlockNumber: block.Number(),
+			Index:       uint(i),
+		}
+
+		// Transactions are emitted once per monitored party so that they're
+		// routed to the sessions of both the sender and recipient
+		for _, addr := range txParties(from, tx.To()) {
+			if _, found := monitored[addr]; !found {
+				continue
+			}
+
+			result = append(result,
+				core.NewEvent(core.Transaction, data, core.WithAddress(addr),
+					core.WithOriginTS(e.OriginTS), core.WithBlockHash(hash)))
+		}
+	}
+
+	return result, nil
+}
+
+// txParties ... Returns the distinct sender and recipient of a transaction.
+// Contract deployments have no recipient
+func txParties(from common.Address, to *common.Address) []common.Address {
+	if to == nil || *to == from {
+		return []common.Address{from}
+	}
+
+	return []common.Address{from, *to}
+}
@@ -0,0 +1,113 @@
+package registry_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxSubscription(t *testing.T) {
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+
+	monitoredKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	monitored := crypto.PubkeyToAddress(monitoredKey.PublicKey)
+	recipient := common.HexToAddress("0x420")
+	other := common.HexToAddress("0x421")
+
+	signTx := func(nonce uint64, to *common.Address, key *ecdsa.PrivateKey) *types.Transaction {
+		return types.MustSignNewTx(key, signer, &types.LegacyTx{
+			Nonce:    nonce,
+			To:       to,
+			Value:    big.NewInt(1),
+			Gas:      21_000,
+			GasPrice: big.NewInt(1),
+		})
+	}
+
+	txs := []*types.Transaction{
+		signTx(0, &other, monitoredKey),     // Sent from a monitored address
+		signTx(0, &recipient, otherKey),     // Sent to a monitored address
+		signTx(1, &other, otherKey),         // Unmonitored
+		signTx(1, nil, monitoredKey),        // Deployed by a monitored address
+		signTx(2, &recipient, monitoredKey), // Sent between monitored addresses
+	}
+
+	header := &types.Header{Number: big.NewInt(10)}
+	block := types.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+
+	var tests = []struct {
+		name     string
+		blockErr error
+		expected []common.Address
+	}{
+		{
+			name:     "Error when failing to fetch block",
+			blockErr: fmt.Errorf("unknown block"),
+		},
+		{
+			name:     "Emit transactions sent from or to monitored addresses",
+			expected: []common.Address{monitored, recipient, monitored, monitored, recipient},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, ms := mocks.Context(context.Background(), gomock.NewController(t))
+
+			sk := &core.StateKey{Prefix: core.Transaction, ID: core.AddressKey}
+			for _, addr := range []common.Address{monitored, recipient} {
+				assert.NoError(t, state.InsertUnique(ctx, sk, addr.String()))
+			}
+
+			sub, err := registry.NewTxSubscription(ctx, core.Layer1)
+			assert.NoError(t, err)
+			sub.SK = sk
+
+			if test.blockErr != nil {
+				ms.MockL1.EXPECT().BlockByHash(gomock.Any(), header.Hash()).
+					Return(nil, test.blockErr).Times(10)
+			} else {
+				ms.MockL1.EXPECT().BlockByHash(gomock.Any(), header.Hash()).
+					Return(block, nil).Times(1)
+			}
+
+			events, err := sub.Run(ctx, core.Event{Value: *header})
+			if test.blockErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, events, len(test.expected))
+
+			for j, e := range events {
+				assert.Equal(t, core.Transaction, e.Type)
+				assert.Equal(t, test.expected[j], e.Address)
+				assert.Equal(t, header.Hash(), e.BlockHash)
+
+				tx, ok := e.Value.(core.Tx)
+				assert.True(t, ok)
+				assert.Equal(t, big.NewInt(10), tx.BlockNumber)
+			}
+
+			deployment, ok := events[2].Value.(core.Tx)
+			assert.True(t, ok)
+			assert.Nil(t, deployment.To())
+			assert.Equal(t, monitored, deployment.From)
+		})
+	}
+}
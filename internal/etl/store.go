@@ -2,6 +2,7 @@ package etl
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/base-org/pessimism/internal/core"
 )
@@ -11,9 +12,12 @@ type Entry struct {
 	p  Path
 }
 
+// Store ... Path store shared by the ETL event loop and API handlers
 type Store struct {
 	paths      map[core.PathIdentifier][]Entry
 	procToPath map[core.ProcessID][]core.PathID
+
+	mu sync.RWMutex
 }
 
 // NewStore ... Initializer
@@ -31,6 +35,13 @@ Note - PathIDs can only conflict
 
 // Link ... Creates an entry for some new C_UUID:P_UUID mapping
 func (store *Store) Link(id1 core.ProcessID, id2 core.PathID) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.link(id1, id2)
+}
+
+func (store *Store) link(id1 core.ProcessID, id2 core.PathID) {
 	// EDGE CASE - C_UUID:P_UUID pair already exists
 	if _, found := store.procToPath[id1]; !found { // Create slice
 		store.procToPath[id1] = make([]core.PathID, 0)
@@ -40,6 +51,9 @@ func (store *Store) Link(id1 core.ProcessID, id2 core.PathID) {
 }
 
 func (store *Store) AddPath(id core.PathID, path Path) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
 	entry := Entry{
 		id: id,
 		p:  path,
@@ -55,21 +69,87 @@ func (store *Store) AddPath(id core.PathID, path Path) {
 	store.paths[id.ID] = entrySlice
 
 	for _, p := range path.Processes() {
-		store.Link(p.ID(), id)
+		store.link(p.ID(), id)
 	}
 }
 
+// Unlink ... Removes all path mappings for a process
+func (store *Store) Unlink(id core.ProcessID) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.procToPath, id)
+}
+
+// RemovePath ... Removes a path entry and its process links from the store
+func (store *Store) RemovePath(id core.PathID) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entries, found := store.paths[id.ID]
+	if !found {
+		return fmt.Errorf(pIDNotFoundErr, id.String())
+	}
+
+	for i, entry := range entries {
+		if entry.id.UUID != id.UUID {
+			continue
+		}
+
+		for _, p := range entry.p.Processes() {
+			store.unlinkPath(p.ID(), id)
+		}
+
+		// A new slice is built so that previously read entries are left intact
+		remaining := make([]Entry, 0, len(entries)-1)
+		remaining = append(remaining, entries[:i]...)
+		remaining = append(remaining, entries[i+1:]...)
+		if len(remaining) == 0 {
+			delete(store.paths, id.ID)
+		} else {
+			store.paths[id.ID] = remaining
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf(uuidNotFoundErr)
+}
+
+// unlinkPath ... Removes a single process to path mapping
+func (store *Store) unlinkPath(pID core.ProcessID, id core.PathID) {
+	ids := make([]core.PathID, 0, len(store.procToPath[pID]))
+	for _, pathID := range store.procToPath[pID] {
+		if pathID != id {
+			ids = append(ids, pathID)
+		}
+	}
+
+	if len(ids) == 0 {
+		delete(store.procToPath, pID)
+		return
+	}
+
+	store.procToPath[pID] = ids
+}
+
 func (store *Store) GetPathIDs(cID core.ProcessID) ([]core.PathID, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	pIDs, found := store.procToPath[cID]
 
 	if !found {
 		return []core.PathID{}, fmt.Errorf("could not find key for %s", cID)
 	}
 
-	return pIDs, nil
+	return append([]core.PathID(nil), pIDs...), nil
 }
 
 func (store *Store) GetPathByID(id core.PathID) (Path, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	if _, found := store.paths[id.ID]; !found {
 		return nil, fmt.Errorf(pIDNotFoundErr, id.String())
 	}
@@ -83,7 +163,26 @@ func (store *Store) GetPathByID(id core.PathID) (Path, error) {
 	return nil, fmt.Errorf(uuidNotFoundErr)
 }
 
+// GetPathByUUID ... Returns a path provided only its UUID
+func (store *Store) GetPathByUUID(id core.UUID) (Path, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for _, entrySlice := range store.paths {
+		for _, entry := range entrySlice {
+			if entry.id.UUID == id {
+				return entry.p, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf(uuidNotFoundErr)
+}
+
 func (store *Store) GetExistingPaths(id core.PathID) []core.PathID {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	entries, exists := store.paths[id.ID]
 	if !exists {
 		return []core.PathID{}
@@ -99,6 +198,9 @@ func (store *Store) GetExistingPaths(id core.PathID) []core.PathID {
 
 // Count ... Returns the number of active paths
 func (store *Store) ActiveCount() int {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	count := 0
 
 	for _, entrySlice := range store.paths {
@@ -113,6 +215,9 @@ func (store *Store) ActiveCount() int {
 }
 
 func (store *Store) Paths() []Path {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	paths := make([]Path, 0)
 
 	for _, entrySlice := range store.paths {
@@ -3,6 +3,7 @@ package etl_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/base-org/pessimism/internal/core"
@@ -239,6 +240,63 @@ func TestStore(t *testing.T) {
 				assert.Equal(t, paths[0], expected)
 			},
 		},
+		{
+			name:        "Successful Path UUID Lookup",
+			function:    "GetPathByUUID",
+			description: "",
+
+			constructionLogic: func() *etl.Store {
+				return etl.NewStore()
+			},
+			testLogic: func(t *testing.T, store *etl.Store) {
+				cID := core.MakeProcessID(0, 0, 0, 0)
+				pID := core.MakePathID(0, cID, cID)
+
+				path := getTestPath(context.Background())
+				store.AddPath(pID, path)
+
+				actual, err := store.GetPathByUUID(pID.UUID)
+				assert.NoError(t, err)
+				assert.Equal(t, path, actual)
+
+				_, err = store.GetPathByUUID(core.NewUUID())
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:        "Successful Path Removal",
+			function:    "RemovePath",
+			description: "",
+
+			constructionLogic: func() *etl.Store {
+				store := etl.NewStore()
+				return store
+			},
+			testLogic: func(t *testing.T, store *etl.Store) {
+				cID := core.MakeProcessID(0, 0, 0, 0)
+				pID := core.MakePathID(0, cID, cID)
+
+				path := getTestPath(context.Background())
+				store.AddPath(pID, path)
+
+				err := store.RemovePath(pID)
+				assert.NoError(t, err)
+
+				assert.Len(t, store.Paths(), 0)
+
+				_, err = store.GetPathByID(pID)
+				assert.Error(t, err)
+
+				for _, p := range path.Processes() {
+					_, err = store.GetPathIDs(p.ID())
+					assert.Error(t, err)
+				}
+
+				// Removing the path again should fail
+				err = store.RemovePath(pID)
+				assert.Error(t, err)
+			},
+		},
 		{
 			name:        "Successful Active Count Call",
 			function:    "ActiveCount",
@@ -260,6 +318,40 @@ func TestStore(t *testing.T) {
 				assert.Equal(t, count, 0)
 			},
 		},
+		{
+			name:        "Successful Concurrent Access",
+			function:    "RemovePath",
+			description: "Paths can be read while being added and removed from other routines",
+
+			constructionLogic: func() *etl.Store {
+				return etl.NewStore()
+			},
+			testLogic: func(t *testing.T, store *etl.Store) {
+				cID := core.MakeProcessID(0, 0, 0, 0)
+
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					pID := core.MakePathID(0, cID, cID)
+					wg.Add(2)
+
+					go func() {
+						defer wg.Done()
+						store.AddPath(pID, getTestPath(context.Background()))
+						assert.NoError(t, store.RemovePath(pID))
+					}()
+
+					go func() {
+						defer wg.Done()
+						_ = store.Paths()
+						_ = store.ActiveCount()
+						_, _ = store.GetPathByUUID(pID.UUID)
+					}()
+				}
+				wg.Wait()
+
+				assert.Empty(t, store.Paths())
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -0,0 +1,132 @@
+package etl
+
+import (
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxProcessRestarts ... Number of process crashes a path can recover from
+	// before it is torn down
+	maxProcessRestarts = 3
+	// restartResetPeriod ... Period a path must run without crashing before
+	// its restart count is reset
+	restartResetPeriod = 10 * time.Minute
+
+	baseRestartBackoff = 1 * time.Second
+	maxRestartBackoff  = 30 * time.Second
+
+	// changeBufferSize ... Buffer size of the process state change and path health channels
+	changeBufferSize = 100
+)
+
+// restartCount ... Number of process crashes a path has recently recovered from
+type restartCount struct {
+	count int
+	last  time.Time
+}
+
+// restartBackoff ... Returns the exponential backoff delay preceding the nth restart
+func restartBackoff(n int) time.Duration {
+	delay := baseRestartBackoff << (n - 1)
+	if delay <= 0 || delay > maxRestartBackoff {
+		return maxRestartBackoff
+	}
+
+	return delay
+}
+
+// supervise ... Handles process state changes. Crashed processes are restarted with
+// exponential backoff and paths whose processes keep crashing are torn down
+func (etl *etl) supervise(sc process.StateChange) {
+	if sc.To != process.Crashed {
+		return
+	}
+
+	logger := logging.WithContext(etl.ctx)
+
+	path, err := etl.store.GetPathByID(sc.PathID)
+	if err != nil { // Path has already been removed
+		delete(etl.restarts, sc.PathID)
+		return
+	}
+
+	// Crashes are only counted towards the teardown once they occur within
+	// the reset period of the previous crash
+	rc, exists := etl.restarts[sc.PathID]
+	if !exists || time.Since(rc.last) > restartResetPeriod {
+		rc = &restartCount{}
+		etl.restarts[sc.PathID] = rc
+	}
+
+	rc.count++
+	rc.last = time.Now()
+	restarts := rc.count
+
+	if restarts > maxProcessRestarts {
+		logger.Error("Tearing down path after repeated process crashes",
+			zap.String(logging.Path, sc.PathID.String()),
+			zap.String(logging.Process, sc.ID.String()),
+			zap.Error(sc.Err))
+
+		delete(etl.restarts, sc.PathID)
+		if err := etl.RemovePath(sc.PathID); err != nil {
+			logger.Error("Could not tear down crashed path",
+				zap.String(logging.Path, sc.PathID.String()),
+				zap.Error(err))
+		}
+
+		etl.notify(core.PathHealthEvent{
+			ID:       sc.PathID,
+			Process:  sc.ID,
+			Health:   core.PathFailed,
+			Restarts: maxProcessRestarts,
+			Err:      sc.Err,
+		})
+		return
+	}
+
+	delay := restartBackoff(restarts)
+	logger.Warn("Restarting crashed process",
+		zap.String(logging.Path, sc.PathID.String()),
+		zap.String(logging.Process, sc.ID.String()),
+		zap.Int("restarts", restarts),
+		zap.Duration("backoff", delay),
+		zap.Error(sc.Err))
+
+	etl.notify(core.PathHealthEvent{
+		ID:       sc.PathID,
+		Process:  sc.ID,
+		Health:   core.PathRestarting,
+		Restarts: restarts,
+		Err:      sc.Err,
+	})
+
+	go func() {
+		select {
+		case <-time.After(delay):
+		case <-etl.ctx.Done():
+			return
+		}
+
+		// Paths removed during the backoff are no longer restarted
+		if err := path.Restart(sc.ID, &etl.wg); err != nil {
+			logger.Warn("Could not restart process",
+				zap.String(logging.Path, sc.PathID.String()),
+				zap.String(logging.Process, sc.ID.String()),
+				zap.Error(err))
+		}
+	}()
+}
+
+// notify ... Sends a path health event to upstream consumers
+func (etl *etl) notify(event core.PathHealthEvent) {
+	select {
+	case etl.health <- event:
+	case <-etl.ctx.Done():
+	}
+}
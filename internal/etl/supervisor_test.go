@@ -0,0 +1,169 @@
+package etl
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/etl/process"
+	"github.com/base-org/pessimism/internal/etl/registry"
+	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// crashingRoutine ... Read routine that fails a set number of times before running until cancelled
+type crashingRoutine struct {
+	failures int
+
+	*sync.Mutex
+}
+
+func (cr *crashingRoutine) Loop(ctx context.Context, _ chan core.Event) error {
+	cr.Lock()
+	if cr.failures != 0 {
+		cr.failures--
+		cr.Unlock()
+		return fmt.Errorf("rpc unavailable")
+	}
+	cr.Unlock()
+
+	<-ctx.Done()
+	return nil
+}
+
+func (cr *crashingRoutine) Height() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (cr *crashingRoutine) Lag() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func readHealth(t *testing.T, events chan core.PathHealthEvent) core.PathHealthEvent {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(15 * time.Second):
+		t.Fatal("timed out waiting for path health event")
+	}
+
+	return core.PathHealthEvent{}
+}
+
+func TestSupervisor(t *testing.T) {
+	var tests = []struct {
+		name     string
+		failures int
+		expected []core.PathHealth
+		removed  bool
+	}{
+		{
+			name:     "Restart crashed process",
+			failures: 1,
+			expected: []core.PathHealth{core.PathRestarting},
+		},
+		{
+			name:     "Tear down path after repeated crashes",
+			failures: -1,
+			expected: []core.PathHealth{core.PathRestarting, core.PathRestarting,
+				core.PathRestarting, core.PathFailed},
+			removed: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ctx, _ := mocks.Context(context.Background(), gomock.NewController(t))
+			ctx = context.WithValue(ctx, core.State, state.NewMemState())
+
+			r := registry.New()
+			e, ok := New(ctx, NewAnalyzer(r), r, NewStore(), NewGraph(), nil).(*etl)
+			assert.True(t, ok)
+
+			pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+				core.MakeProcessID(core.Live, 0, 0, 0))
+			procID := core.MakeProcessID(core.Live, core.Read, core.BlockHeader, core.Layer1)
+
+			p, err := process.NewReader(ctx, core.BlockHeader,
+				&crashingRoutine{failures: test.failures, Mutex: &sync.Mutex{}},
+				process.WithID(procID), process.WithPathID(pathID), process.WithEventChan(e.changes))
+			assert.NoError(t, err)
+
+			path, err := NewPath(&core.PathConfig{}, pathID, []process.Process{p})
+			assert.NoError(t, err)
+			e.store.AddPath(pathID, path)
+
+			go func() {
+				_ = e.EventLoop()
+			}()
+			assert.NoError(t, e.Run(pathID))
+
+			for j, health := range test.expected {
+				event := readHealth(t, e.HealthEvents())
+				assert.Equal(t, pathID, event.ID)
+				assert.Equal(t, procID, event.Process)
+				assert.Equal(t, health, event.Health)
+				assert.Error(t, event.Err)
+
+				if health == core.PathRestarting {
+					assert.Equal(t, j+1, event.Restarts)
+				}
+			}
+
+			if test.removed {
+				_, err = e.store.GetPathByID(pathID)
+				assert.Error(t, err)
+			} else {
+				assert.Eventually(t, func() bool {
+					return p.ActivityState() == process.Live
+				}, 5*time.Second, 10*time.Millisecond)
+				assert.Equal(t, ACTIVE, path.State())
+			}
+
+			assert.NoError(t, e.Shutdown())
+		})
+	}
+}
+
+func TestSupervisorRestartReset(t *testing.T) {
+	ctx, _ := mocks.Context(context.Background(), gomock.NewController(t))
+	ctx = context.WithValue(ctx, core.State, state.NewMemState())
+
+	r := registry.New()
+	e, ok := New(ctx, NewAnalyzer(r), r, NewStore(), NewGraph(), nil).(*etl)
+	assert.True(t, ok)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	procID := core.MakeProcessID(core.Live, core.Read, core.BlockHeader, core.Layer1)
+
+	p, err := process.NewReader(ctx, core.BlockHeader,
+		&crashingRoutine{Mutex: &sync.Mutex{}},
+		process.WithID(procID), process.WithPathID(pathID), process.WithEventChan(e.changes))
+	assert.NoError(t, err)
+
+	path, err := NewPath(&core.PathConfig{}, pathID, []process.Process{p})
+	assert.NoError(t, err)
+	e.store.AddPath(pathID, path)
+
+	// Crashes after a healthy period shouldn't count towards the path teardown
+	e.restarts[pathID] = &restartCount{
+		count: maxProcessRestarts,
+		last:  time.Now().Add(-2 * restartResetPeriod),
+	}
+
+	e.supervise(process.StateChange{ID: procID, PathID: pathID, To: process.Crashed,
+		Err: fmt.Errorf("rpc unavailable")})
+
+	event := readHealth(t, e.HealthEvents())
+	assert.Equal(t, core.PathRestarting, event.Health)
+	assert.Equal(t, 1, event.Restarts)
+
+	assert.NoError(t, e.Shutdown())
+}
@@ -37,8 +37,14 @@ const (
 	procNotFoundErr = "process with ID %s does not exist within dag"
 	procExistsErr   = "process with ID %s already exists in dag"
 	edgeExistsErr   = "edge already exists from (%s) to (%s) in dag"
+	edgeNotFoundErr = "edge does not exist from (%s) to (%s) in dag"
+
+	downstreamEdgesErr = "process with ID %s has %d downstream edges and cannot be removed"
+
+	procNotCrashedErr = "process with ID %s has not crashed"
 
 	emptyPathError = "path must contain at least one process"
 	// Manager error constants
 	unknownCompType = "unknown process type %s provided"
+	noCheckpointErr = "path %s is not checkpointed"
 )
@@ -36,7 +36,9 @@ type Config struct {
 // Metricer ... Interface for metrics
 type Metricer interface {
 	IncMissedBlock(id core.PathID)
+	AddMissedBlocks(id core.PathID, n int)
 	IncActiveHeuristics(ht core.HeuristicType, network core.Network)
+	DecActiveHeuristics(ht core.HeuristicType, network core.Network)
 	IncActivePaths(network core.Network)
 	DecActivePaths(network core.Network)
 	RecordBlockLatency(network core.Network, latency float64)
@@ -221,11 +223,21 @@ func (m *Metrics) IncMissedBlock(id core.PathID) {
 	m.MissedBlocks.WithLabelValues(id.String()).Inc()
 }
 
+// AddMissedBlocks ... Increases the number of missed blocks by n
+func (m *Metrics) AddMissedBlocks(id core.PathID, n int) {
+	m.MissedBlocks.WithLabelValues(id.String()).Add(float64(n))
+}
+
 // IncActiveHeuristics ... Increments the number of active heuristics
 func (m *Metrics) IncActiveHeuristics(ht core.HeuristicType, n core.Network) {
 	m.ActiveHeuristics.WithLabelValues(ht.String(), n.String()).Inc()
 }
 
+// DecActiveHeuristics ... Decrements the number of active heuristics
+func (m *Metrics) DecActiveHeuristics(ht core.HeuristicType, n core.Network) {
+	m.ActiveHeuristics.WithLabelValues(ht.String(), n.String()).Dec()
+}
+
 // IncActivePaths ... Increments the number of active paths
 func (m *Metrics) IncActivePaths(n core.Network) {
 	m.ActivePaths.WithLabelValues(n.String()).Inc()
@@ -306,10 +318,14 @@ type noopMetricer struct{}
 
 var NoopMetrics Metricer = new(noopMetricer)
 
-func (n *noopMetricer) IncMissedBlock(_ core.PathID) {}
-func (n *noopMetricer) RecordUp()                    {}
+func (n *noopMetricer) IncMissedBlock(_ core.PathID)         {}
+func (n *noopMetricer) AddMissedBlocks(_ core.PathID, _ int) {}
+func (n *noopMetricer) RecordUp()                            {}
 func (n *noopMetricer) IncActiveHeuristics(_ core.HeuristicType, _ core.Network) {
 }
+
+func (n *noopMetricer) DecActiveHeuristics(_ core.HeuristicType, _ core.Network) {
+}
 func (n *noopMetricer) RecordAssessmentTime(_ heuristic.Heuristic, _ float64)                {}
 func (n *noopMetricer) IncActivePaths(_ core.Network)                                        {}
 func (n *noopMetricer) DecActivePaths(_ core.Network)                                        {}
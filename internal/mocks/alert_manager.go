@@ -62,6 +62,35 @@ func (mr *AlertManagerMockRecorder) EventLoop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventLoop", reflect.TypeOf((*AlertManager)(nil).EventLoop))
 }
 
+// GetAlertPolicy mocks base method.
+func (m *AlertManager) GetAlertPolicy(arg0 core.UUID) (*core.AlertPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertPolicy", arg0)
+	ret0, _ := ret[0].(*core.AlertPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertPolicy indicates an expected call of GetAlertPolicy.
+func (mr *AlertManagerMockRecorder) GetAlertPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertPolicy", reflect.TypeOf((*AlertManager)(nil).GetAlertPolicy), arg0)
+}
+
+// RemoveSession mocks base method.
+func (m *AlertManager) RemoveSession(arg0 core.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSession", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSession indicates an expected call of RemoveSession.
+func (mr *AlertManagerMockRecorder) RemoveSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSession", reflect.TypeOf((*AlertManager)(nil).RemoveSession), arg0)
+}
+
 // Shutdown mocks base method.
 func (m *AlertManager) Shutdown() error {
 	m.ctrl.T.Helper()
@@ -89,3 +118,17 @@ func (mr *AlertManagerMockRecorder) Transit() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transit", reflect.TypeOf((*AlertManager)(nil).Transit))
 }
+
+// UpdateSession mocks base method.
+func (m *AlertManager) UpdateSession(arg0 core.UUID, arg1 *core.AlertPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *AlertManagerMockRecorder) UpdateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*AlertManager)(nil).UpdateSession), arg0, arg1)
+}
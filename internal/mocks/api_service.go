@@ -63,6 +63,65 @@ func (mr *MockServiceMockRecorder) CheckHealth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockService)(nil).CheckHealth))
 }
 
+// GetPath mocks base method.
+func (m *MockService) GetPath(arg0 core.UUID) (*models.PathSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPath", arg0)
+	ret0, _ := ret[0].(*models.PathSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPath indicates an expected call of GetPath.
+func (mr *MockServiceMockRecorder) GetPath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*MockService)(nil).GetPath), arg0)
+}
+
+// GetPaths mocks base method.
+func (m *MockService) GetPaths() []*models.PathSummary {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaths")
+	ret0, _ := ret[0].([]*models.PathSummary)
+	return ret0
+}
+
+// GetPaths indicates an expected call of GetPaths.
+func (mr *MockServiceMockRecorder) GetPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaths", reflect.TypeOf((*MockService)(nil).GetPaths))
+}
+
+// GetSession mocks base method.
+func (m *MockService) GetSession(arg0 core.UUID) (*models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0)
+	ret0, _ := ret[0].(*models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockServiceMockRecorder) GetSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockService)(nil).GetSession), arg0)
+}
+
+// GetSessions mocks base method.
+func (m *MockService) GetSessions() ([]*models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions")
+	ret0, _ := ret[0].([]*models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockServiceMockRecorder) GetSessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockService)(nil).GetSessions))
+}
+
 // ProcessHeuristicRequest mocks base method.
 func (m *MockService) ProcessHeuristicRequest(arg0 *models.SessionRequestBody) (core.UUID, error) {
 	m.ctrl.T.Helper()
@@ -92,3 +151,31 @@ func (mr *MockServiceMockRecorder) RunHeuristicSession(arg0 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunHeuristicSession", reflect.TypeOf((*MockService)(nil).RunHeuristicSession), arg0)
 }
+
+// StopHeuristicSession mocks base method.
+func (m *MockService) StopHeuristicSession(arg0 core.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopHeuristicSession", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopHeuristicSession indicates an expected call of StopHeuristicSession.
+func (mr *MockServiceMockRecorder) StopHeuristicSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopHeuristicSession", reflect.TypeOf((*MockService)(nil).StopHeuristicSession), arg0)
+}
+
+// UpdateHeuristicSession mocks base method.
+func (m *MockService) UpdateHeuristicSession(arg0 core.UUID, arg1 *models.SessionRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHeuristicSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHeuristicSession indicates an expected call of UpdateHeuristicSession.
+func (mr *MockServiceMockRecorder) UpdateHeuristicSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHeuristicSession", reflect.TypeOf((*MockService)(nil).UpdateHeuristicSession), arg0, arg1)
+}
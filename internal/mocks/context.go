@@ -17,6 +17,9 @@ type MockSuite struct {
 	MockL1      *MockEthClient
 	MockL2      *MockEthClient
 	MockL2Node  *MockNodeClient
+	MockL1Geth  *MockGethClient
+	MockL2Geth  *MockGethClient
+	MockOpNode  *MockOpNodeClient
 	SS          state.Store
 }
 
@@ -26,6 +29,8 @@ func Context(ctx context.Context, ctrl *gomock.Controller) (context.Context, *Mo
 	mockedClient := NewMockEthClient(ctrl)
 	mockedIndexer := NewMockIxClient(ctrl)
 	mockedNode := NewMockNodeClient(ctrl)
+	mockedGeth := NewMockGethClient(ctrl)
+	mockedOpNode := NewMockOpNodeClient(ctrl)
 
 	ss := state.NewMemState()
 
@@ -33,8 +38,11 @@ func Context(ctx context.Context, ctrl *gomock.Controller) (context.Context, *Mo
 		IxClient: mockedIndexer,
 		L1Client: mockedClient,
 		L1Node:   mockedNode,
+		L1Geth:   mockedGeth,
 		L2Client: mockedClient,
 		L2Node:   mockedNode,
+		L2Geth:   mockedGeth,
+		OpNode:   mockedOpNode,
 	}
 
 	// 2. Bind to context
@@ -50,6 +58,9 @@ func Context(ctx context.Context, ctrl *gomock.Controller) (context.Context, *Mo
 		MockL1Node:  mockedNode,
 		MockL2:      mockedClient,
 		MockL2Node:  mockedNode,
+		MockL1Geth:  mockedGeth,
+		MockL2Geth:  mockedGeth,
+		MockOpNode:  mockedOpNode,
 		SS:          ss,
 	}
 
@@ -36,10 +36,10 @@ func (m *EngineManager) EXPECT() *EngineManagerMockRecorder {
 }
 
 // DeleteHeuristicSession mocks base method.
-func (m *EngineManager) DeleteHeuristicSession(arg0 core.UUID) (core.UUID, error) {
+func (m *EngineManager) DeleteHeuristicSession(arg0 core.UUID) (core.PathID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteHeuristicSession", arg0)
-	ret0, _ := ret[0].(core.UUID)
+	ret0, _ := ret[0].(core.PathID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -94,6 +94,49 @@ func (mr *EngineManagerMockRecorder) GetInputType(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInputType", reflect.TypeOf((*EngineManager)(nil).GetInputType), arg0)
 }
 
+// GetPathSessions mocks base method.
+func (m *EngineManager) GetPathSessions(arg0 core.PathID) []core.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathSessions", arg0)
+	ret0, _ := ret[0].([]core.UUID)
+	return ret0
+}
+
+// GetPathSessions indicates an expected call of GetPathSessions.
+func (mr *EngineManagerMockRecorder) GetPathSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathSessions", reflect.TypeOf((*EngineManager)(nil).GetPathSessions), arg0)
+}
+
+// GetSessionConfig mocks base method.
+func (m *EngineManager) GetSessionConfig(arg0 core.UUID) (*heuristic.DeployConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionConfig", arg0)
+	ret0, _ := ret[0].(*heuristic.DeployConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionConfig indicates an expected call of GetSessionConfig.
+func (mr *EngineManagerMockRecorder) GetSessionConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionConfig", reflect.TypeOf((*EngineManager)(nil).GetSessionConfig), arg0)
+}
+
+// GetSessions mocks base method.
+func (m *EngineManager) GetSessions() []core.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions")
+	ret0, _ := ret[0].([]core.UUID)
+	return ret0
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *EngineManagerMockRecorder) GetSessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*EngineManager)(nil).GetSessions))
+}
+
 // Shutdown mocks base method.
 func (m *EngineManager) Shutdown() error {
 	m.ctrl.T.Helper()
@@ -121,3 +164,17 @@ func (mr *EngineManagerMockRecorder) Transit() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transit", reflect.TypeOf((*EngineManager)(nil).Transit))
 }
+
+// UpdateHeuristicSession mocks base method.
+func (m *EngineManager) UpdateHeuristicSession(arg0 core.UUID, arg1 *core.SessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHeuristicSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHeuristicSession indicates an expected call of UpdateHeuristicSession.
+func (mr *EngineManagerMockRecorder) UpdateHeuristicSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHeuristicSession", reflect.TypeOf((*EngineManager)(nil).UpdateHeuristicSession), arg0, arg1)
+}
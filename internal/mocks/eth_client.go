@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/base-org/pessimism/internal/client (interfaces: EthClient,NodeClient)
+// Source: github.com/base-org/pessimism/internal/client (interfaces: EthClient,NodeClient,HeadSubscriber)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -53,6 +53,21 @@ func (mr *MockEthClientMockRecorder) BalanceAt(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockEthClient)(nil).BalanceAt), arg0, arg1, arg2)
 }
 
+// BlockByHash mocks base method.
+func (m *MockEthClient) BlockByHash(arg0 context.Context, arg1 common.Hash) (*types.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockByHash", arg0, arg1)
+	ret0, _ := ret[0].(*types.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockByHash indicates an expected call of BlockByHash.
+func (mr *MockEthClientMockRecorder) BlockByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByHash", reflect.TypeOf((*MockEthClient)(nil).BlockByHash), arg0, arg1)
+}
+
 // BlockByNumber mocks base method.
 func (m *MockEthClient) BlockByNumber(arg0 context.Context, arg1 *big.Int) (*types.Block, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +143,21 @@ func (mr *MockEthClientMockRecorder) HeaderByNumber(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockEthClient)(nil).HeaderByNumber), arg0, arg1)
 }
 
+// StorageAt mocks base method.
+func (m *MockEthClient) StorageAt(arg0 context.Context, arg1 common.Address, arg2 common.Hash, arg3 *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageAt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorageAt indicates an expected call of StorageAt.
+func (mr *MockEthClientMockRecorder) StorageAt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageAt", reflect.TypeOf((*MockEthClient)(nil).StorageAt), arg0, arg1, arg2, arg3)
+}
+
 // SubscribeFilterLogs mocks base method.
 func (m *MockEthClient) SubscribeFilterLogs(arg0 context.Context, arg1 ethereum.FilterQuery, arg2 chan<- types.Log) (ethereum.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +173,21 @@ func (mr *MockEthClientMockRecorder) SubscribeFilterLogs(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFilterLogs", reflect.TypeOf((*MockEthClient)(nil).SubscribeFilterLogs), arg0, arg1, arg2)
 }
 
+// TransactionReceipt mocks base method.
+func (m *MockEthClient) TransactionReceipt(arg0 context.Context, arg1 common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockEthClientMockRecorder) TransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockEthClient)(nil).TransactionReceipt), arg0, arg1)
+}
+
 // MockNodeClient is a mock of NodeClient interface.
 type MockNodeClient struct {
 	ctrl     *gomock.Controller
@@ -255,3 +300,41 @@ func (mr *MockNodeClientMockRecorder) TxByHash(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxByHash", reflect.TypeOf((*MockNodeClient)(nil).TxByHash), arg0)
 }
+
+// MockHeadSubscriber is a mock of HeadSubscriber interface.
+type MockHeadSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeadSubscriberMockRecorder
+}
+
+// MockHeadSubscriberMockRecorder is the mock recorder for MockHeadSubscriber.
+type MockHeadSubscriberMockRecorder struct {
+	mock *MockHeadSubscriber
+}
+
+// NewMockHeadSubscriber creates a new mock instance.
+func NewMockHeadSubscriber(ctrl *gomock.Controller) *MockHeadSubscriber {
+	mock := &MockHeadSubscriber{ctrl: ctrl}
+	mock.recorder = &MockHeadSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeadSubscriber) EXPECT() *MockHeadSubscriberMockRecorder {
+	return m.recorder
+}
+
+// SubscribeNewHead mocks base method.
+func (m *MockHeadSubscriber) SubscribeNewHead(arg0 context.Context, arg1 chan<- *types.Header) (ethereum.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHead", arg0, arg1)
+	ret0, _ := ret[0].(ethereum.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHead indicates an expected call of SubscribeNewHead.
+func (mr *MockHeadSubscriberMockRecorder) SubscribeNewHead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHead", reflect.TypeOf((*MockHeadSubscriber)(nil).SubscribeNewHead), arg0, arg1)
+}
@@ -110,6 +110,50 @@ func (mr *MockETLMockRecorder) GetBlockHeight(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeight", reflect.TypeOf((*MockETL)(nil).GetBlockHeight), arg0)
 }
 
+// GetCheckpoint mocks base method.
+func (m *MockETL) GetCheckpoint(arg0 core.PathID) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", arg0)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockETLMockRecorder) GetCheckpoint(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockETL)(nil).GetCheckpoint), arg0)
+}
+
+// GetPath mocks base method.
+func (m *MockETL) GetPath(arg0 core.UUID) (*core.PathInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPath", arg0)
+	ret0, _ := ret[0].(*core.PathInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPath indicates an expected call of GetPath.
+func (mr *MockETLMockRecorder) GetPath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*MockETL)(nil).GetPath), arg0)
+}
+
+// GetPaths mocks base method.
+func (m *MockETL) GetPaths() []*core.PathInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaths")
+	ret0, _ := ret[0].([]*core.PathInfo)
+	return ret0
+}
+
+// GetPaths indicates an expected call of GetPaths.
+func (mr *MockETLMockRecorder) GetPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaths", reflect.TypeOf((*MockETL)(nil).GetPaths))
+}
+
 // GetStateKey mocks base method.
 func (m *MockETL) GetStateKey(arg0 core.TopicType) (*core.StateKey, bool, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +170,34 @@ func (mr *MockETLMockRecorder) GetStateKey(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateKey", reflect.TypeOf((*MockETL)(nil).GetStateKey), arg0)
 }
 
+// HealthEvents mocks base method.
+func (m *MockETL) HealthEvents() chan core.PathHealthEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthEvents")
+	ret0, _ := ret[0].(chan core.PathHealthEvent)
+	return ret0
+}
+
+// HealthEvents indicates an expected call of HealthEvents.
+func (mr *MockETLMockRecorder) HealthEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthEvents", reflect.TypeOf((*MockETL)(nil).HealthEvents))
+}
+
+// RemovePath mocks base method.
+func (m *MockETL) RemovePath(arg0 core.PathID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePath", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePath indicates an expected call of RemovePath.
+func (mr *MockETLMockRecorder) RemovePath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePath", reflect.TypeOf((*MockETL)(nil).RemovePath), arg0)
+}
+
 // Run mocks base method.
 func (m *MockETL) Run(arg0 core.PathID) error {
 	m.ctrl.T.Helper()
@@ -140,6 +212,20 @@ func (mr *MockETLMockRecorder) Run(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockETL)(nil).Run), arg0)
 }
 
+// SetCheckpoint mocks base method.
+func (m *MockETL) SetCheckpoint(arg0 core.PathID, arg1 *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCheckpoint indicates an expected call of SetCheckpoint.
+func (mr *MockETLMockRecorder) SetCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCheckpoint", reflect.TypeOf((*MockETL)(nil).SetCheckpoint), arg0, arg1)
+}
+
 // Shutdown mocks base method.
 func (m *MockETL) Shutdown() error {
 	m.ctrl.T.Helper()
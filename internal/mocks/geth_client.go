@@ -9,6 +9,7 @@ import (
 	big "math/big"
 	reflect "reflect"
 
+	core "github.com/base-org/pessimism/internal/core"
 	common "github.com/ethereum/go-ethereum/common"
 	gethclient "github.com/ethereum/go-ethereum/ethclient/gethclient"
 	gomock "github.com/golang/mock/gomock"
@@ -51,3 +52,18 @@ func (mr *MockGethClientMockRecorder) GetProof(arg0, arg1, arg2, arg3 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockGethClient)(nil).GetProof), arg0, arg1, arg2, arg3)
 }
+
+// TraceBlockByHash mocks base method.
+func (m *MockGethClient) TraceBlockByHash(arg0 context.Context, arg1 common.Hash) ([]core.TxTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceBlockByHash", arg0, arg1)
+	ret0, _ := ret[0].([]core.TxTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceBlockByHash indicates an expected call of TraceBlockByHash.
+func (mr *MockGethClientMockRecorder) TraceBlockByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceBlockByHash", reflect.TypeOf((*MockGethClient)(nil).TraceBlockByHash), arg0, arg1)
+}
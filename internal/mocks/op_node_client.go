@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/base-org/pessimism/internal/client (interfaces: OpNodeClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	eth "github.com/ethereum-optimism/optimism/op-service/eth"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOpNodeClient is a mock of OpNodeClient interface.
+type MockOpNodeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpNodeClientMockRecorder
+}
+
+// MockOpNodeClientMockRecorder is the mock recorder for MockOpNodeClient.
+type MockOpNodeClientMockRecorder struct {
+	mock *MockOpNodeClient
+}
+
+// NewMockOpNodeClient creates a new mock instance.
+func NewMockOpNodeClient(ctrl *gomock.Controller) *MockOpNodeClient {
+	mock := &MockOpNodeClient{ctrl: ctrl}
+	mock.recorder = &MockOpNodeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpNodeClient) EXPECT() *MockOpNodeClientMockRecorder {
+	return m.recorder
+}
+
+// SyncStatus mocks base method.
+func (m *MockOpNodeClient) SyncStatus(arg0 context.Context) (*eth.SyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", arg0)
+	ret0, _ := ret[0].(*eth.SyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockOpNodeClientMockRecorder) SyncStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockOpNodeClient)(nil).SyncStatus), arg0)
+}
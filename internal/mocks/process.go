@@ -37,6 +37,10 @@ func (md *mockTraversal) Height() (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
+func (md *mockTraversal) Lag() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
 // NewReader
 func NewReader(ctx context.Context, ot core.TopicType, opts ...process.Option) (process.Process, error) {
 	mt := &mockTraversal{}
@@ -67,6 +67,79 @@ func (mr *SubManagerMockRecorder) BuildPathCfg(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildPathCfg", reflect.TypeOf((*SubManager)(nil).BuildPathCfg), arg0)
 }
 
+// GetPath mocks base method.
+func (m *SubManager) GetPath(arg0 core.UUID) (*models.PathSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPath", arg0)
+	ret0, _ := ret[0].(*models.PathSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPath indicates an expected call of GetPath.
+func (mr *SubManagerMockRecorder) GetPath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*SubManager)(nil).GetPath), arg0)
+}
+
+// GetPaths mocks base method.
+func (m *SubManager) GetPaths() []*models.PathSummary {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaths")
+	ret0, _ := ret[0].([]*models.PathSummary)
+	return ret0
+}
+
+// GetPaths indicates an expected call of GetPaths.
+func (mr *SubManagerMockRecorder) GetPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaths", reflect.TypeOf((*SubManager)(nil).GetPaths))
+}
+
+// GetSession mocks base method.
+func (m *SubManager) GetSession(arg0 core.UUID) (*models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0)
+	ret0, _ := ret[0].(*models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *SubManagerMockRecorder) GetSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*SubManager)(nil).GetSession), arg0)
+}
+
+// GetSessions mocks base method.
+func (m *SubManager) GetSessions() ([]*models.SessionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions")
+	ret0, _ := ret[0].([]*models.SessionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *SubManagerMockRecorder) GetSessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*SubManager)(nil).GetSessions))
+}
+
+// RecordSession mocks base method.
+func (m *SubManager) RecordSession(arg0 core.UUID, arg1 *models.SessionRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSession indicates an expected call of RecordSession.
+func (mr *SubManagerMockRecorder) RecordSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSession", reflect.TypeOf((*SubManager)(nil).RecordSession), arg0, arg1)
+}
+
 // RunHeuristic mocks base method.
 func (m *SubManager) RunHeuristic(arg0 *heuristic.DeployConfig) (core.UUID, error) {
 	m.ctrl.T.Helper()
@@ -107,3 +180,45 @@ func (mr *SubManagerMockRecorder) StartEventRoutines(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEventRoutines", reflect.TypeOf((*SubManager)(nil).StartEventRoutines), arg0)
 }
+
+// StopHeuristic mocks base method.
+func (m *SubManager) StopHeuristic(arg0 core.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopHeuristic", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopHeuristic indicates an expected call of StopHeuristic.
+func (mr *SubManagerMockRecorder) StopHeuristic(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopHeuristic", reflect.TypeOf((*SubManager)(nil).StopHeuristic), arg0)
+}
+
+// UpdateHeuristic mocks base method.
+func (m *SubManager) UpdateHeuristic(arg0 core.UUID, arg1 *core.SessionConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHeuristic", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHeuristic indicates an expected call of UpdateHeuristic.
+func (mr *SubManagerMockRecorder) UpdateHeuristic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHeuristic", reflect.TypeOf((*SubManager)(nil).UpdateHeuristic), arg0, arg1)
+}
+
+// UpdateSessionRecord mocks base method.
+func (m *SubManager) UpdateSessionRecord(arg0 core.UUID, arg1 *models.SessionRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionRecord indicates an expected call of UpdateSessionRecord.
+func (mr *SubManagerMockRecorder) UpdateSessionRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionRecord", reflect.TypeOf((*SubManager)(nil).UpdateSessionRecord), arg0, arg1)
+}
@@ -0,0 +1,107 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// recordPrefix ... Key prefix for session records
+var recordPrefix = []byte("session-")
+
+// levelStore ... LevelDB backed session store
+type levelStore struct {
+	db *leveldb.DB
+}
+
+// NewLevelDBStore ... Opens or creates a LevelDB session store at the provided path
+func NewLevelDBStore(path string) (Store, error) {
+	db, err := leveldb.OpenFile(filepath.Clean(path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelStore{db: db}, nil
+}
+
+// recordKey ... Returns the database key of a session record
+func recordKey(id core.UUID) []byte {
+	return append(append([]byte{}, recordPrefix...), []byte(id.String())...)
+}
+
+// Put ... Inserts or replaces a session record
+func (ls *levelStore) Put(rec *Record) error {
+	bytes, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return ls.db.Put(recordKey(rec.ID), bytes, nil)
+}
+
+// Get ... Returns the session record for a session UUID
+func (ls *levelStore) Get(id core.UUID) (*Record, error) {
+	bytes, err := ls.db.Get(recordKey(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, fmt.Errorf(recordNotFoundErr, ErrRecordNotFound, id.String())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &Record{}
+	if err := json.Unmarshal(bytes, rec); err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// All ... Returns all session records
+func (ls *levelStore) All() ([]*Record, error) {
+	iter := ls.db.NewIterator(util.BytesPrefix(recordPrefix), nil)
+	defer iter.Release()
+
+	recs := make([]*Record, 0)
+	for iter.Next() {
+		rec := &Record{}
+		if err := json.Unmarshal(iter.Value(), rec); err != nil {
+			return nil, err
+		}
+
+		recs = append(recs, rec)
+	}
+
+	return recs, iter.Error()
+}
+
+// SetHeight ... Updates the last processed block height of a session record
+func (ls *levelStore) SetHeight(id core.UUID, height *big.Int) error {
+	rec, err := ls.Get(id)
+	if err != nil {
+		return err
+	}
+
+	rec.Height = height
+	return ls.Put(rec)
+}
+
+// Remove ... Removes a session record
+func (ls *levelStore) Remove(id core.UUID) error {
+	if _, err := ls.Get(id); err != nil {
+		return err
+	}
+
+	return ls.db.Delete(recordKey(id), nil)
+}
+
+// Close ... Closes the underlying database
+func (ls *levelStore) Close() error {
+	return ls.db.Close()
+}
@@ -0,0 +1,121 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/base-org/pessimism/internal/api/models"
+	"github.com/base-org/pessimism/internal/core"
+)
+
+const (
+	recordNotFoundErr = "%w for %s"
+)
+
+// ErrRecordNotFound ... Returned when a session UUID has no record in the store
+var ErrRecordNotFound = errors.New("could not find session record")
+
+// Record ... Durable representation of an accepted heuristic session request
+type Record struct {
+	ID     core.UUID                   `json:"id"`
+	Params models.SessionRequestParams `json:"params"`
+	// Height ... Last block height processed by the session's path
+	Height *big.Int `json:"height"`
+}
+
+// Store ... Interface for a durable heuristic session registry
+type Store interface {
+	Put(rec *Record) error
+	Get(id core.UUID) (*Record, error)
+	All() ([]*Record, error)
+	SetHeight(id core.UUID, height *big.Int) error
+	Remove(id core.UUID) error
+
+	Close() error
+}
+
+// memStore ... In memory session store used when no durable store is configured
+type memStore struct {
+	records map[core.UUID]*Record
+
+	sync.RWMutex
+}
+
+// NewMemStore ... Initializer
+func NewMemStore() Store {
+	return &memStore{
+		records: make(map[core.UUID]*Record),
+	}
+}
+
+// Put ... Inserts or replaces a session record
+func (ms *memStore) Put(rec *Record) error {
+	ms.Lock()
+	defer ms.Unlock()
+
+	cp := *rec
+	ms.records[rec.ID] = &cp
+	return nil
+}
+
+// Get ... Returns the session record for a session UUID
+func (ms *memStore) Get(id core.UUID) (*Record, error) {
+	ms.RLock()
+	defer ms.RUnlock()
+
+	rec, found := ms.records[id]
+	if !found {
+		return nil, fmt.Errorf(recordNotFoundErr, ErrRecordNotFound, id.String())
+	}
+
+	cp := *rec
+	return &cp, nil
+}
+
+// All ... Returns all session records
+func (ms *memStore) All() ([]*Record, error) {
+	ms.RLock()
+	defer ms.RUnlock()
+
+	recs := make([]*Record, 0, len(ms.records))
+	for _, rec := range ms.records {
+		cp := *rec
+		recs = append(recs, &cp)
+	}
+
+	return recs, nil
+}
+
+// SetHeight ... Updates the last processed block height of a session record
+func (ms *memStore) SetHeight(id core.UUID, height *big.Int) error {
+	ms.Lock()
+	defer ms.Unlock()
+
+	rec, found := ms.records[id]
+	if !found {
+		return fmt.Errorf(recordNotFoundErr, ErrRecordNotFound, id.String())
+	}
+
+	rec.Height = height
+	return nil
+}
+
+// Remove ... Removes a session record
+func (ms *memStore) Remove(id core.UUID) error {
+	ms.Lock()
+	defer ms.Unlock()
+
+	if _, found := ms.records[id]; !found {
+		return fmt.Errorf(recordNotFoundErr, ErrRecordNotFound, id.String())
+	}
+
+	delete(ms.records, id)
+	return nil
+}
+
+// Close ... No-op for the in memory store
+func (ms *memStore) Close() error {
+	return nil
+}
@@ -0,0 +1,109 @@
+package session_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/api/models"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	var tests = []struct {
+		name        string
+		constructor func(t *testing.T) session.Store
+	}{
+		{
+			name: "Memory",
+			constructor: func(t *testing.T) session.Store {
+				return session.NewMemStore()
+			},
+		},
+		{
+			name: "LevelDB",
+			constructor: func(t *testing.T) session.Store {
+				store, err := session.NewLevelDBStore(t.TempDir())
+				assert.NoError(t, err)
+
+				return store
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			store := test.constructor(t)
+			defer store.Close()
+
+			id := core.NewUUID()
+			rec := &session.Record{
+				ID: id,
+				Params: models.SessionRequestParams{
+					Network:       core.Layer1.String(),
+					HeuristicType: core.BalanceEnforcement.String(),
+					SessionParams: map[string]interface{}{"address": "0x420"},
+				},
+			}
+
+			// 1. Unknown records should not be found
+			_, err := store.Get(id)
+			assert.True(t, errors.Is(err, session.ErrRecordNotFound))
+			assert.Error(t, store.SetHeight(id, big.NewInt(1)))
+			assert.Error(t, store.Remove(id))
+
+			// 2. Inserted records should be retrievable
+			assert.NoError(t, store.Put(rec))
+
+			actual, err := store.Get(id)
+			assert.NoError(t, err)
+			assert.Equal(t, rec.ID, actual.ID)
+			assert.Equal(t, rec.Params, actual.Params)
+			assert.Nil(t, actual.Height)
+
+			// 3. Heights should be recorded
+			assert.NoError(t, store.SetHeight(id, big.NewInt(420)))
+
+			recs, err := store.All()
+			assert.NoError(t, err)
+			assert.Len(t, recs, 1)
+			assert.Equal(t, big.NewInt(420), recs[0].Height)
+
+			// 4. Removed records should no longer be found
+			assert.NoError(t, store.Remove(id))
+
+			recs, err = store.All()
+			assert.NoError(t, err)
+			assert.Empty(t, recs)
+		})
+	}
+}
+
+func TestLevelDBStoreReopen(t *testing.T) {
+	dir := t.TempDir()
+	id := core.NewUUID()
+
+	store, err := session.NewLevelDBStore(dir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.Put(&session.Record{
+		ID:     id,
+		Params: models.SessionRequestParams{HeuristicType: core.BalanceEnforcement.String()},
+	}))
+	assert.NoError(t, store.SetHeight(id, big.NewInt(100)))
+	assert.NoError(t, store.Close())
+
+	// Records should survive a restart
+	store, err = session.NewLevelDBStore(dir)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	rec, err := store.Get(id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, rec.ID)
+	assert.Equal(t, core.BalanceEnforcement.String(), rec.Params.HeuristicType)
+	assert.Equal(t, big.NewInt(100), rec.Height)
+}
@@ -2,6 +2,8 @@ package state
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/logging"
@@ -27,3 +29,86 @@ func InsertUnique(ctx context.Context, sk *core.StateKey, value string) error {
 	}
 	return nil
 }
+
+// RemoveEntry ... Removes an entry from the state store slice for a key
+func RemoveEntry(ctx context.Context, sk *core.StateKey, value string) error {
+	ss, err := FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ss.RemoveSliceValue(ctx, sk, value)
+}
+
+// SetValue ... Replaces the single value stored for a key
+func SetValue(ctx context.Context, sk *core.StateKey, value string) error {
+	ss, err := FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ss.SetValue(ctx, sk, value)
+}
+
+// GetValue ... Returns the single value stored for a key and whether one was found.
+// Missing keys aren't treated as errors
+func GetValue(ctx context.Context, sk *core.StateKey) (string, bool, error) {
+	ss, err := FromContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	vals, err := ss.GetSlice(ctx, sk)
+	if err != nil && isNotFoundError(err, sk) {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(vals) != 1 {
+		return "", false, fmt.Errorf(multipleValuesErr, sk)
+	}
+
+	return vals[0], true, nil
+}
+
+// SetCheckpoint ... Replaces the block height stored for a checkpoint key
+func SetCheckpoint(ctx context.Context, sk *core.StateKey, height *big.Int) error {
+	return SetValue(ctx, sk, height.String())
+}
+
+// GetCheckpoint ... Returns the block height stored for a checkpoint key
+func GetCheckpoint(ctx context.Context, sk *core.StateKey) (*big.Int, error) {
+	ss, err := FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := ss.GetSlice(ctx, sk)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vals) != 1 {
+		return nil, fmt.Errorf(invalidCheckpointErr, sk)
+	}
+
+	height, ok := new(big.Int).SetString(vals[0], 10)
+	if !ok {
+		return nil, fmt.Errorf(invalidCheckpointErr, sk)
+	}
+
+	return height, nil
+}
+
+// RemoveCheckpoint ... Removes the block height stored for a checkpoint key
+func RemoveCheckpoint(ctx context.Context, sk *core.StateKey) error {
+	ss, err := FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ss.Remove(ctx, sk)
+}
@@ -0,0 +1,69 @@
+package state_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Checkpoint(t *testing.T) {
+	ss := state.NewMemState()
+	ctx := context.WithValue(context.Background(), core.State, ss)
+	ck := core.MakeCheckpointKey(core.PathIdentifier{}, 0)
+
+	// 1. Unknown checkpoints should fail retrieval
+	_, err := state.GetCheckpoint(ctx, ck)
+	assert.Error(t, err)
+
+	// 2. Checkpoints should be replaced rather than appended
+	assert.NoError(t, state.SetCheckpoint(ctx, ck, big.NewInt(1)))
+	assert.NoError(t, state.SetCheckpoint(ctx, ck, big.NewInt(2)))
+
+	height, err := state.GetCheckpoint(ctx, ck)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), height)
+
+	// 3. Malformed checkpoints should fail retrieval
+	_, err = ss.SetSlice(ctx, ck, "0xabc")
+	assert.NoError(t, err)
+
+	_, err = state.GetCheckpoint(ctx, ck)
+	assert.Error(t, err)
+
+	// 4. Removed checkpoints should no longer be found
+	assert.NoError(t, state.RemoveCheckpoint(ctx, ck))
+
+	_, err = state.GetCheckpoint(ctx, ck)
+	assert.Error(t, err)
+}
+
+func Test_Value(t *testing.T) {
+	ss := state.NewMemState()
+	ctx := context.WithValue(context.Background(), core.State, ss)
+	sk := core.MakeStateKey(core.Log, "value", false)
+
+	// 1. Unknown keys should not be found
+	_, found, err := state.GetValue(ctx, sk)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// 2. Values should be replaced rather than appended
+	assert.NoError(t, state.SetValue(ctx, sk, "a"))
+	assert.NoError(t, state.SetValue(ctx, sk, "b"))
+
+	val, found, err := state.GetValue(ctx, sk)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "b", val)
+
+	// 3. Keys holding multiple values should fail retrieval
+	_, err = ss.SetSlice(ctx, sk, "c")
+	assert.NoError(t, err)
+
+	_, _, err = state.GetValue(ctx, sk)
+	assert.Error(t, err)
+}
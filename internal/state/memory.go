@@ -11,6 +11,10 @@ import (
 const (
 	valAlreadySetError = "value already exists in state store"
 	notFoundError      = "could not find state store value for key %s"
+	valNotFoundError   = "could not find value %s in state store slice for key %s"
+
+	invalidCheckpointErr = "could not parse checkpoint height for key %s"
+	multipleValuesErr    = "expected a single state store value for key %s"
 )
 
 // IsValAlreadySetError ... Checks if the error is a ValAlreadySetError
@@ -18,6 +22,11 @@ func isValAlreadySetError(err error) bool {
 	return err.Error() == valAlreadySetError
 }
 
+// isNotFoundError ... Checks if the error is a notFoundError for the key
+func isNotFoundError(err error, key *core.StateKey) bool {
+	return err.Error() == fmt.Sprintf(notFoundError, key)
+}
+
 /*
 	NOTE - This is a temporary implementation of the state store.
 */
@@ -70,6 +79,39 @@ func (ss *stateStore) SetSlice(_ context.Context, key *core.StateKey, value stri
 	return value, nil
 }
 
+// RemoveSliceValue ... Removes a value from the store slice,
+// deleting the key entry once the slice is empty
+func (ss *stateStore) RemoveSliceValue(_ context.Context, key *core.StateKey, value string) error {
+	ss.Lock()
+	defer ss.Unlock()
+
+	entries, exists := ss.sliceStore[key.String()]
+	if !exists {
+		return fmt.Errorf(notFoundError, key)
+	}
+
+	for i, entry := range entries {
+		if entry != value {
+			continue
+		}
+
+		// Build a new slice so that previously returned slices aren't mutated
+		updated := make([]string, 0, len(entries)-1)
+		updated = append(updated, entries[:i]...)
+		updated = append(updated, entries[i+1:]...)
+
+		if len(updated) == 0 {
+			delete(ss.sliceStore, key.String())
+		} else {
+			ss.sliceStore[key.String()] = updated
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf(valNotFoundError, value, key)
+}
+
 // Remove ... Removes a key entry from the store
 func (ss *stateStore) Remove(_ context.Context, key *core.StateKey) error {
 	ss.Lock()
@@ -78,3 +120,12 @@ func (ss *stateStore) Remove(_ context.Context, key *core.StateKey) error {
 	delete(ss.sliceStore, key.String())
 	return nil
 }
+
+// SetValue ... Replaces the store slice with a single value
+func (ss *stateStore) SetValue(_ context.Context, key *core.StateKey, value string) error {
+	ss.Lock()
+	defer ss.Unlock()
+
+	ss.sliceStore[key.String()] = []string{value}
+	return nil
+}
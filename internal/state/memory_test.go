@@ -66,6 +66,46 @@ func Test_MemState(t *testing.T) {
 				assert.NoError(t, err, "should not error")
 			},
 		},
+		{
+			name:        "Test_Remove_Slice_Value",
+			description: "Test removal of a single slice value when multiple values are prepopulated",
+			function:    "RemoveSliceValue",
+			construction: func() state.Store {
+				ss := state.NewMemState()
+				for _, val := range []string{testValue, "0xdef"} {
+					if _, err := ss.SetSlice(context.Background(), testKey, val); err != nil {
+						panic(err)
+					}
+				}
+
+				return ss
+			},
+			testLogic: func(t *testing.T, ss state.Store) {
+				err := ss.RemoveSliceValue(context.Background(), testKey, testValue)
+				assert.NoError(t, err)
+
+				val, err := ss.GetSlice(context.Background(), testKey)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"0xdef"}, val)
+
+				// Key should be deleted once the slice is empty
+				err = ss.RemoveSliceValue(context.Background(), testKey, "0xdef")
+				assert.NoError(t, err)
+
+				_, err = ss.GetSlice(context.Background(), testKey)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:         "Test_Remove_Slice_Value_Fail",
+			description:  "Test failed slice value removal when key doesn't exist",
+			function:     "RemoveSliceValue",
+			construction: state.NewMemState,
+			testLogic: func(t *testing.T, ss state.Store) {
+				err := ss.RemoveSliceValue(context.Background(), testKey, testValue)
+				assert.Error(t, err)
+			},
+		},
 	}
 
 	// TODO - Consider making generic test helpers for this
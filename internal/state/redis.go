@@ -0,0 +1,144 @@
+package state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultNamespace ... Key namespace used when none is configured
+	DefaultNamespace = "pessimism"
+
+	// pathScope ... Key scope of state bound to a path. Path UUIDs are regenerated
+	// on every run, so path scoped state is discarded on startup
+	pathScope = "path"
+
+	// purgeBatchSize ... Number of keys scanned per purge iteration
+	purgeBatchSize = 1000
+)
+
+// redisStore ... Redis backed state store that represents
+// each state slice as a set keyed by the state key. Keys are prefixed
+// with a namespace so that replicas can share a Redis instance
+type redisStore struct {
+	client    redis.UniversalClient
+	namespace string
+}
+
+// NewRedisState ... Initializer
+func NewRedisState(client redis.UniversalClient, namespace string) Store {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+
+	return &redisStore{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// key ... Returns the namespaced Redis key of a state key
+func (rs *redisStore) key(sk *core.StateKey) string {
+	if sk.PathID != nil {
+		return fmt.Sprintf("%s:%s:%s", rs.namespace, pathScope, sk.String())
+	}
+
+	return fmt.Sprintf("%s:%s", rs.namespace, sk.String())
+}
+
+// purgePathState ... Deletes the path scoped state left behind by previous runs
+// within the store's namespace. Must be called before any path is started
+func (rs *redisStore) purgePathState(ctx context.Context) error {
+	pattern := fmt.Sprintf("%s:%s:*", rs.namespace, pathScope)
+
+	var cursor uint64
+	for {
+		keys, next, err := rs.client.Scan(ctx, cursor, pattern, purgeBatchSize).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := rs.client.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// GetSlice ... Fetches all members of the set stored for a key
+func (rs *redisStore) GetSlice(ctx context.Context, key *core.StateKey) ([]string, error) {
+	vals, err := rs.client.SMembers(ctx, rs.key(key)).Result()
+	if err != nil {
+		return []string{}, err
+	}
+
+	// Redis deletes sets once they're empty
+	if len(vals) == 0 {
+		return []string{}, fmt.Errorf(notFoundError, key)
+	}
+
+	return vals, nil
+}
+
+// SetSlice ... Adds a value to the set stored for a key
+func (rs *redisStore) SetSlice(ctx context.Context, key *core.StateKey, value string) (string, error) {
+	added, err := rs.client.SAdd(ctx, rs.key(key), value).Result()
+	if err != nil {
+		return "", err
+	}
+
+	if added == 0 {
+		return "", fmt.Errorf(valAlreadySetError)
+	}
+
+	return value, nil
+}
+
+// RemoveSliceValue ... Removes a value from the set stored for a key
+func (rs *redisStore) RemoveSliceValue(ctx context.Context, key *core.StateKey, value string) error {
+	removed, err := rs.client.SRem(ctx, rs.key(key), value).Result()
+	if err != nil {
+		return err
+	}
+
+	if removed > 0 {
+		return nil
+	}
+
+	exists, err := rs.client.Exists(ctx, rs.key(key)).Result()
+	if err != nil {
+		return err
+	}
+
+	if exists == 0 {
+		return fmt.Errorf(notFoundError, key)
+	}
+
+	return fmt.Errorf(valNotFoundError, value, key)
+}
+
+// Remove ... Removes a key entry from the store
+func (rs *redisStore) Remove(ctx context.Context, key *core.StateKey) error {
+	return rs.client.Del(ctx, rs.key(key)).Err()
+}
+
+// SetValue ... Replaces the set stored for a key with a single member
+// within a transaction so that readers never observe an empty or partial set
+func (rs *redisStore) SetValue(ctx context.Context, key *core.StateKey, value string) error {
+	_, err := rs.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, rs.key(key))
+		pipe.SAdd(ctx, rs.key(key), value)
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,246 @@
+package state_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/base-org/pessimism/internal/core"
+	"github.com/base-org/pessimism/internal/state"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RedisState(t *testing.T) {
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+
+	testKey := &core.StateKey{Prefix: 1, ID: "test", PathID: &pathID}
+	testValue := "0xabc"
+
+	// Path scoped keys are namespaced under the path scope
+	redisKey := "test:path:" + testKey.String()
+
+	var tests = []struct {
+		name     string
+		function string
+
+		testLogic func(t *testing.T, ss state.Store, mr *miniredis.Miniredis)
+	}{
+		{
+			name:     "Test_Set_Success",
+			function: "SetSlice",
+			testLogic: func(t *testing.T, ss state.Store, mr *miniredis.Miniredis) {
+				_, err := ss.SetSlice(context.Background(), testKey, testValue)
+				assert.NoError(t, err)
+
+				val, err := ss.GetSlice(context.Background(), testKey)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{testValue}, val)
+
+				// Values should be stored as a set keyed by the state key
+				members, err := mr.SMembers(redisKey)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{testValue}, members)
+			},
+		},
+		{
+			name:     "Test_Set_Duplicate_Fail",
+			function: "SetSlice",
+			testLogic: func(t *testing.T, ss state.Store, _ *miniredis.Miniredis) {
+				_, err := ss.SetSlice(context.Background(), testKey, testValue)
+				assert.NoError(t, err)
+
+				// Duplicates should be tolerated by the unique insertion accessor
+				_, err = ss.SetSlice(context.Background(), testKey, testValue)
+				assert.Error(t, err)
+
+				ctx := context.WithValue(context.Background(), core.State, ss)
+				assert.NoError(t, state.InsertUnique(ctx, testKey, testValue))
+			},
+		},
+		{
+			name:     "Test_Get_Fail",
+			function: "GetSlice",
+			testLogic: func(t *testing.T, ss state.Store, _ *miniredis.Miniredis) {
+				_, err := ss.GetSlice(context.Background(), testKey)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:     "Test_Remove",
+			function: "Remove",
+			testLogic: func(t *testing.T, ss state.Store, mr *miniredis.Miniredis) {
+				_, err := ss.SetSlice(context.Background(), testKey, testValue)
+				assert.NoError(t, err)
+
+				err = ss.Remove(context.Background(), testKey)
+				assert.NoError(t, err)
+				assert.False(t, mr.Exists(redisKey))
+			},
+		},
+		{
+			name:     "Test_Remove_Slice_Value",
+			function: "RemoveSliceValue",
+			testLogic: func(t *testing.T, ss state.Store, mr *miniredis.Miniredis) {
+				for _, val := range []string{testValue, "0xdef"} {
+					_, err := ss.SetSlice(context.Background(), testKey, val)
+					assert.NoError(t, err)
+				}
+
+				err := ss.RemoveSliceValue(context.Background(), testKey, testValue)
+				assert.NoError(t, err)
+
+				val, err := ss.GetSlice(context.Background(), testKey)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"0xdef"}, val)
+
+				// Unknown values should fail removal
+				err = ss.RemoveSliceValue(context.Background(), testKey, testValue)
+				assert.Error(t, err)
+
+				// Key should be deleted once the set is empty
+				err = ss.RemoveSliceValue(context.Background(), testKey, "0xdef")
+				assert.NoError(t, err)
+				assert.False(t, mr.Exists(redisKey))
+			},
+		},
+		{
+			name:     "Test_Set_Value_Success",
+			function: "SetValue",
+			testLogic: func(t *testing.T, ss state.Store, mr *miniredis.Miniredis) {
+				_, err := ss.SetSlice(context.Background(), testKey, "0xabc")
+				assert.NoError(t, err)
+				_, err = ss.SetSlice(context.Background(), testKey, "0xdef")
+				assert.NoError(t, err)
+
+				// Existing members should be replaced by the value
+				assert.NoError(t, ss.SetValue(context.Background(), testKey, testValue))
+
+				members, err := mr.Members(redisKey)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{testValue}, members)
+			},
+		},
+		{
+			name:     "Test_Namespace_Isolation",
+			function: "SetValue",
+			testLogic: func(t *testing.T, ss state.Store, mr *miniredis.Miniredis) {
+				ck := core.MakeCheckpointKey(pathID.ID, 0)
+				assert.NoError(t, ss.SetValue(context.Background(), ck, "100"))
+
+				// Keys that aren't path scoped shouldn't collide across namespaces
+				client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+				defer client.Close()
+
+				other := state.NewRedisState(client, "other")
+				assert.NoError(t, other.SetValue(context.Background(), ck, "200"))
+
+				val, err := ss.GetSlice(context.Background(), ck)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"100"}, val)
+				assert.True(t, mr.Exists("test:"+ck.String()))
+			},
+		},
+		{
+			name:     "Test_Remove_Slice_Value_Fail",
+			function: "RemoveSliceValue",
+			testLogic: func(t *testing.T, ss state.Store, _ *miniredis.Miniredis) {
+				err := ss.RemoveSliceValue(context.Background(), testKey, testValue)
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s-%s", i, test.name, test.function), func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			defer client.Close()
+
+			test.testLogic(t, state.NewRedisState(client, "test"), mr)
+		})
+	}
+}
+
+func Test_RedisPurge(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	tracked := &core.StateKey{Prefix: 1, ID: "test", PathID: &pathID}
+	ck := core.MakeCheckpointKey(pathID.ID, 0)
+
+	// 1. Seed state as left behind by a previous run and another replica
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	ctx := context.Background()
+	for _, ss := range []state.Store{state.NewRedisState(client, "test"), state.NewRedisState(client, "other")} {
+		_, err := ss.SetSlice(ctx, tracked, "0xabc")
+		assert.NoError(t, err)
+		assert.NoError(t, ss.SetValue(ctx, ck, "100"))
+	}
+
+	// 2. Starting the store should only purge the path scoped state of its namespace
+	ss, cleanup, err := state.New(ctx, &state.Config{
+		Type:           state.RedisType,
+		RedisURL:       "redis://" + mr.Addr(),
+		RedisNamespace: "test",
+	})
+	assert.NoError(t, err)
+	defer cleanup()
+
+	_, err = ss.GetSlice(ctx, tracked)
+	assert.Error(t, err)
+
+	val, err := ss.GetSlice(ctx, ck)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"100"}, val)
+
+	assert.True(t, mr.Exists("other:path:"+tracked.String()))
+}
+
+func Test_New(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	var tests = []struct {
+		name   string
+		cfg    *state.Config
+		hasErr bool
+	}{
+		{
+			name: "Memory store",
+			cfg:  &state.Config{Type: state.MemoryType},
+		},
+		{
+			name: "Redis store",
+			cfg:  &state.Config{Type: state.RedisType, RedisURL: "redis://" + mr.Addr()},
+		},
+		{
+			name:   "Failure with invalid redis URL",
+			cfg:    &state.Config{Type: state.RedisType, RedisURL: "localhost"},
+			hasErr: true,
+		},
+		{
+			name:   "Failure with unknown store type",
+			cfg:    &state.Config{Type: "postgres"},
+			hasErr: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ss, cleanup, err := state.New(context.Background(), test.cfg)
+			if test.hasErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, ss)
+			cleanup()
+		})
+	}
+}
@@ -5,15 +5,67 @@ import (
 	"fmt"
 
 	"github.com/base-org/pessimism/internal/core"
+	"github.com/redis/go-redis/v9"
 )
 
+const (
+	// MemoryType ... In memory state store type
+	MemoryType = "memory"
+	// RedisType ... Redis state store type
+	RedisType = "redis"
+
+	unknownTypeErr = "unknown state store type %s"
+)
+
+// Config ... State store configuration
+type Config struct {
+	Type     string
+	RedisURL string
+	// RedisNamespace ... Prefix of all Redis keys. Replicas sharing a Redis
+	// instance must each use a distinct namespace
+	RedisNamespace string
+}
+
 // Store ... Interface for a state store
-// TODO() - Add optional redis store implementation
 type Store interface {
 	GetSlice(context.Context, *core.StateKey) ([]string, error)
 
 	SetSlice(context.Context, *core.StateKey, string) (string, error)
+	RemoveSliceValue(context.Context, *core.StateKey, string) error
 	Remove(context.Context, *core.StateKey) error
+
+	// SetValue ... Atomically replaces the slice stored for a key with a single value
+	SetValue(context.Context, *core.StateKey, string) error
+}
+
+// New ... Initializes the state store type defined by the config
+func New(ctx context.Context, cfg *Config) (Store, func(), error) {
+	switch cfg.Type {
+	case MemoryType, "":
+		return NewMemState(), func() {}, nil
+
+	case RedisType:
+		opts, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		client := redis.NewClient(opts)
+		if err := client.Ping(ctx).Err(); err != nil {
+			_ = client.Close()
+			return nil, nil, err
+		}
+
+		rs, _ := NewRedisState(client, cfg.RedisNamespace).(*redisStore)
+		if err := rs.purgePathState(ctx); err != nil {
+			_ = client.Close()
+			return nil, nil, err
+		}
+
+		return rs, func() { _ = client.Close() }, nil
+	}
+
+	return nil, nil, fmt.Errorf(unknownTypeErr, cfg.Type)
 }
 
 // FromContext ... Fetches a state store from context
@@ -1,7 +1,23 @@
 package subsystem
 
+import (
+	"time"
+)
+
+const (
+	// checkpointInterval ... Interval at which durable session block heights are recorded
+	checkpointInterval = 10 * time.Second
+	// lagCheckInterval ... Interval at which path block lag is checked
+	lagCheckInterval = 30 * time.Second
+)
+
 const (
 	networkNotFoundErr = "could not find endpoint for network %s"
 
 	maxPathErr = "max etl path count reached: %d"
+
+	pathLagMsg = "path %s on %s is %s blocks behind the chain head"
+
+	pathRestartMsg = "process %s of path %s crashed and is being restarted (attempt %d), affecting sessions %v: %v"
+	pathFailedMsg  = "path %s was torn down after %d failed process restarts and session %s was stopped: %v"
 )
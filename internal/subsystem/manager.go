@@ -4,8 +4,10 @@ package subsystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,24 +19,49 @@ import (
 	"github.com/base-org/pessimism/internal/etl"
 	"github.com/base-org/pessimism/internal/logging"
 	"github.com/base-org/pessimism/internal/metrics"
+	"github.com/base-org/pessimism/internal/session"
 	"go.uber.org/zap"
 )
 
 // Config ... Used to store necessary API service config values
 type Config struct {
-	MaxPathCount   int
+	MaxPathCount int
+	// L1PollInterval & L2PollInterval ... Block header poll intervals in milliseconds
 	L1PollInterval int
 	L2PollInterval int
+	// PollFallback ... Poll for new headers while a head subscription is down
+	PollFallback bool
+
+	L1Confirmations uint64
+	L2Confirmations uint64
+
+	// MaxPathLag ... Number of blocks a path can fall behind the chain head before
+	// an internal alert is raised; zero disables lag alerting
+	MaxPathLag uint64
 }
 
 // GetPollInterval ... Returns config poll-interval for network type
 func (cfg *Config) GetPollInterval(n core.Network) (time.Duration, error) {
 	switch n {
 	case core.Layer1:
-		return time.Duration(cfg.L1PollInterval), nil
+		return time.Duration(cfg.L1PollInterval) * time.Millisecond, nil
+
+	case core.Layer2:
+		return time.Duration(cfg.L2PollInterval) * time.Millisecond, nil
+
+	default:
+		return 0, fmt.Errorf(networkNotFoundErr, n.String())
+	}
+}
+
+// GetConfirmationDepth ... Returns config confirmation depth for network type
+func (cfg *Config) GetConfirmationDepth(n core.Network) (uint64, error) {
+	switch n {
+	case core.Layer1:
+		return cfg.L1Confirmations, nil
 
 	case core.Layer2:
-		return time.Duration(cfg.L2PollInterval), nil
+		return cfg.L2Confirmations, nil
 
 	default:
 		return 0, fmt.Errorf(networkNotFoundErr, n.String())
@@ -45,6 +72,15 @@ type Subsystem interface {
 	BuildDeployCfg(pConfig *core.PathConfig, sConfig *core.SessionConfig) (*heuristic.DeployConfig, error)
 	BuildPathCfg(params *models.SessionRequestParams) (*core.PathConfig, error)
 	RunHeuristic(cfg *heuristic.DeployConfig) (core.UUID, error)
+	RecordSession(id core.UUID, params *models.SessionRequestParams) error
+	UpdateSessionRecord(id core.UUID, params *models.SessionRequestParams) error
+	StopHeuristic(id core.UUID) error
+	UpdateHeuristic(id core.UUID, sConfig *core.SessionConfig) error
+	// Inspection
+	GetSession(id core.UUID) (*models.SessionSummary, error)
+	GetSessions() ([]*models.SessionSummary, error)
+	GetPath(id core.UUID) (*models.PathSummary, error)
+	GetPaths() []*models.PathSummary
 	// Orchestration
 	StartEventRoutines(ctx context.Context)
 	Shutdown() error
@@ -52,27 +88,33 @@ type Subsystem interface {
 
 // manager ... Subsystem manager struct
 type Manager struct {
-	cfg *Config
-	ctx context.Context
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
 
-	etl   etl.ETL
-	eng   engine.Manager
-	alert alert.Manager
-	stats metrics.Metricer
+	etl      etl.ETL
+	eng      engine.Manager
+	alert    alert.Manager
+	sessions session.Store
+	stats    metrics.Metricer
 
 	*sync.WaitGroup
 }
 
 // NewManager ... Initializer for the subsystem manager
 func NewManager(ctx context.Context, cfg *Config, etl etl.ETL, eng engine.Manager,
-	a alert.Manager,
+	a alert.Manager, sessions session.Store,
 ) *Manager {
+	ctx, cancel := context.WithCancel(ctx)
+
 	return &Manager{
 		cfg:       cfg,
 		ctx:       ctx,
+		cancel:    cancel,
 		etl:       etl,
 		eng:       eng,
 		alert:     a,
+		sessions:  sessions,
 		stats:     metrics.WithContext(ctx),
 		WaitGroup: &sync.WaitGroup{},
 	}
@@ -80,6 +122,11 @@ func NewManager(ctx context.Context, cfg *Config, etl etl.ETL, eng engine.Manage
 
 // Shutdown ... Shuts down all subsystems in primary data flow order
 func (m *Manager) Shutdown() error {
+	m.cancel()
+
+	// Record the final session heights before paths are torn down
+	m.checkpointSessions()
+
 	// 1. Shutdown ETL subsystem
 	if err := m.etl.Shutdown(); err != nil {
 		return err
@@ -91,7 +138,12 @@ func (m *Manager) Shutdown() error {
 	}
 
 	// 3. Shutdown Alert subsystem
-	return m.alert.Shutdown()
+	if err := m.alert.Shutdown(); err != nil {
+		return err
+	}
+
+	// 4. Close durable session store
+	return m.sessions.Close()
 }
 
 // StartEventRoutines ... Starts the event loop routines for the subsystems
@@ -124,6 +176,57 @@ func (m *Manager) StartEventRoutines(ctx context.Context) {
 			logger.Error("ETL manager event loop error", zap.Error(err))
 		}
 	}()
+
+	m.Add(1)
+	go func() { // ETL path health thread
+		defer m.Done()
+
+		for {
+			select {
+			case event := <-m.etl.HealthEvents():
+				m.HandlePathHealth(event)
+
+			case <-m.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	m.Add(1)
+	go func() { // Durable session checkpoint thread
+		defer m.Done()
+
+		ticker := time.NewTicker(checkpointInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.checkpointSessions()
+
+			case <-m.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	m.Add(1)
+	go func() { // Path lag monitoring thread
+		defer m.Done()
+
+		ticker := time.NewTicker(lagCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.CheckPathLag()
+
+			case <-m.ctx.Done():
+				return
+			}
+		}
+	}()
 }
 
 // BuildDeployCfg ... Builds a deploy config provided a path & session config
@@ -191,6 +294,259 @@ func (m *Manager) RunHeuristic(cfg *heuristic.DeployConfig) (core.UUID, error) {
 	return id, nil
 }
 
+// RecordSession ... Records the request params of a newly deployed heuristic session
+// in the durable session store
+func (m *Manager) RecordSession(id core.UUID, params *models.SessionRequestParams) error {
+	return m.sessions.Put(&session.Record{
+		ID:     id,
+		Params: *params,
+	})
+}
+
+// UpdateSessionRecord ... Merges updated request params into the record of a durable
+// session. Sessions without a record (e.g. bootstrapped sessions) are left unrecorded
+func (m *Manager) UpdateSessionRecord(id core.UUID, params *models.SessionRequestParams) error {
+	rec, err := m.sessions.Get(id)
+	if errors.Is(err, session.ErrRecordNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(params.SessionParams) > 0 {
+		rec.Params.SessionParams = params.SessionParams
+	}
+
+	if params.AlertingParams != nil {
+		rec.Params.AlertingParams = params.AlertingParams
+	}
+
+	return m.sessions.Put(rec)
+}
+
+// RestoreSessions ... Redeploys all sessions in the durable session store using their
+// original UUIDs. Restored paths resume from the block after the later of their recorded
+// height and state store checkpoint. Records that fail to restore are logged and skipped
+func (m *Manager) RestoreSessions() ([]core.SessionID, error) {
+	recs, err := m.sessions.All()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]core.SessionID, 0, len(recs))
+	for _, rec := range recs {
+		pathID, err := m.restoreSession(rec)
+		if err != nil {
+			logging.WithContext(m.ctx).Error("Could not restore heuristic session",
+				zap.String(logging.UUID, rec.ID.ShortString()),
+				zap.Error(err))
+			continue
+		}
+
+		ids = append(ids, core.SessionID{
+			HeuristicID: rec.ID,
+			PathID:      pathID,
+		})
+
+		logging.WithContext(m.ctx).Info("Restored heuristic session",
+			zap.String(logging.UUID, rec.ID.ShortString()),
+			zap.String(logging.Path, pathID.String()))
+	}
+
+	return ids, nil
+}
+
+// restoreSession ... Redeploys a single durable session record
+func (m *Manager) restoreSession(rec *session.Record) (core.PathID, error) {
+	pConfig, err := m.BuildPathCfg(&rec.Params)
+	if err != nil {
+		return core.PathID{}, err
+	}
+
+	cfg, err := m.BuildDeployCfg(pConfig, rec.Params.SessionConfig())
+	if err != nil {
+		return core.PathID{}, err
+	}
+
+	// Reused paths are already running and can't be repositioned
+	if rec.Height != nil && !cfg.Reuse {
+		if err := m.etl.SetCheckpoint(cfg.PathID, rec.Height); err != nil {
+			logging.WithContext(m.ctx).Warn("Could not resume session from its recorded height",
+				zap.String(logging.UUID, rec.ID.ShortString()),
+				zap.String("height", rec.Height.String()),
+				zap.Error(err))
+		}
+	}
+
+	cfg.ID = rec.ID
+	if _, err := m.RunHeuristic(cfg); err != nil {
+		return core.PathID{}, err
+	}
+
+	return cfg.PathID, nil
+}
+
+// checkpointSessions ... Records the path checkpoint of every durable session
+func (m *Manager) checkpointSessions() {
+	logger := logging.WithContext(m.ctx)
+
+	recs, err := m.sessions.All()
+	if err != nil {
+		logger.Error("Could not fetch durable sessions", zap.Error(err))
+		return
+	}
+
+	for _, rec := range recs {
+		cfg, err := m.eng.GetSessionConfig(rec.ID)
+		if err != nil { // Session hasn't been restored yet
+			continue
+		}
+
+		height, err := m.etl.GetCheckpoint(cfg.PathID)
+		if err != nil { // Path hasn't processed any blocks yet
+			continue
+		}
+
+		if rec.Height != nil && rec.Height.Cmp(height) == 0 {
+			continue
+		}
+
+		if err := m.sessions.SetHeight(rec.ID, height); err != nil {
+			logger.Error("Could not record session height", zap.Error(err),
+				zap.String(logging.UUID, rec.ID.ShortString()))
+		}
+	}
+}
+
+// HandlePathHealth ... Raises internal alerts for unhealthy paths. Process restarts are
+// alerted once per path, while every heuristic session bound to a torn down path is alerted
+// on and removed from the risk engine and alert manager. Removed sessions are kept in the
+// durable session store so that they're restored on restart
+func (m *Manager) HandlePathHealth(event core.PathHealthEvent) {
+	logger := logging.WithContext(m.ctx)
+	ids := m.eng.GetPathSessions(event.ID)
+
+	if event.Health == core.PathRestarting {
+		m.sendInternalAlert(event.ID.UUID, event.ID,
+			fmt.Sprintf(pathRestartMsg, event.Process.String(), event.ID.String(),
+				event.Restarts, ids, event.Err))
+		return
+	}
+
+	for _, id := range ids {
+		m.sendInternalAlert(id, event.ID,
+			fmt.Sprintf(pathFailedMsg, event.ID.String(), event.Restarts, id.String(), event.Err))
+
+		if _, err := m.eng.DeleteHeuristicSession(id); err != nil {
+			logger.Error("Could not remove heuristic session of failed path",
+				zap.String(logging.UUID, id.ShortString()), zap.Error(err))
+			continue
+		}
+
+		if err := m.alert.RemoveSession(id); err != nil {
+			logger.Error("Could not remove alert session of failed path",
+				zap.String(logging.UUID, id.ShortString()), zap.Error(err))
+		}
+	}
+}
+
+// sendInternalAlert ... Sends an internal alert about a path to the alert manager.
+// Internal alert cool downs are keyed by the provided UUID
+func (m *Manager) sendInternalAlert(id core.UUID, pathID core.PathID, content string) {
+	alert := core.Alert{
+		Internal:    true,
+		HeuristicID: id,
+		PathID:      pathID,
+		Net:         pathID.Network(),
+		Timestamp:   time.Now(),
+		Content:     content,
+	}
+
+	select {
+	case m.alert.Transit() <- alert:
+	case <-m.ctx.Done():
+	}
+}
+
+// CheckPathLag ... Raises an internal alert for every path that has fallen
+// more than the configured number of blocks behind the chain head
+func (m *Manager) CheckPathLag() {
+	if m.cfg.MaxPathLag == 0 {
+		return
+	}
+
+	maxLag := new(big.Int).SetUint64(m.cfg.MaxPathLag)
+	for _, info := range m.etl.GetPaths() {
+		if info.Lag == nil || info.Lag.Cmp(maxLag) <= 0 {
+			continue
+		}
+
+		logging.WithContext(m.ctx).Warn("Path is lagging behind the chain head",
+			zap.String(logging.Path, info.ID.String()),
+			zap.String("lag", info.Lag.String()))
+
+		m.sendInternalAlert(info.ID.UUID, info.ID, fmt.Sprintf(pathLagMsg,
+			info.ID.UUID.String(), info.ID.Network().String(), info.Lag.String()))
+	}
+}
+
+// StopHeuristic ... Stops a heuristic session, tearing down its path
+// once no other sessions are bound to it
+func (m *Manager) StopHeuristic(id core.UUID) error {
+	// 1. Remove heuristic session from risk engine
+	pathID, err := m.eng.DeleteHeuristicSession(id)
+	if err != nil {
+		return err
+	}
+
+	// 2. Remove session from alert manager
+	if err := m.alert.RemoveSession(id); err != nil {
+		return err
+	}
+
+	// 3. Remove session from durable store if it was recorded
+	if _, err := m.sessions.Get(id); err == nil {
+		if err := m.sessions.Remove(id); err != nil {
+			return err
+		}
+	}
+
+	logging.WithContext(m.ctx).
+		Info("Stopped heuristic session", zap.String(logging.UUID, id.ShortString()))
+
+	// 4. Remove path if no longer used
+	if len(m.eng.GetPathSessions(pathID)) > 0 {
+		return nil
+	}
+
+	return m.etl.RemovePath(pathID)
+}
+
+// UpdateHeuristic ... Updates the params and alert policy of a heuristic session in place.
+// Nil session params or alert policies are left unchanged
+func (m *Manager) UpdateHeuristic(id core.UUID, sConfig *core.SessionConfig) error {
+	// 1. Update heuristic session in risk engine
+	if sConfig.Params != nil {
+		if err := m.eng.UpdateHeuristicSession(id, sConfig.Params); err != nil {
+			return err
+		}
+	}
+
+	// 2. Update session alert policy in alert manager
+	if sConfig.AlertPolicy != nil {
+		if err := m.alert.UpdateSession(id, sConfig.AlertPolicy); err != nil {
+			return err
+		}
+	}
+
+	logging.WithContext(m.ctx).
+		Info("Updated heuristic session", zap.String(logging.UUID, id.ShortString()))
+
+	return nil
+}
+
 // BuildPathCfg ... Builds a path config provided a set of heuristic request params
 func (m *Manager) BuildPathCfg(params *models.SessionRequestParams) (*core.PathConfig, error) {
 	inType, err := m.eng.GetInputType(params.Heuristic())
@@ -203,15 +559,27 @@ func (m *Manager) BuildPathCfg(params *models.SessionRequestParams) (*core.PathC
 		return nil, err
 	}
 
+	// Session level confirmation depth takes precedence over the network default
+	depth, err := m.cfg.GetConfirmationDepth(params.NetworkType())
+	if err != nil {
+		return nil, err
+	}
+
+	if params.ConfirmationDepth != nil {
+		depth = *params.ConfirmationDepth
+	}
+
 	return &core.PathConfig{
 		Network:  params.NetworkType(),
 		DataType: inType,
 		PathType: core.Live,
 		ClientConfig: &core.ClientConfig{
-			Network:      params.NetworkType(),
-			PollInterval: pollInterval,
-			StartHeight:  params.StartHeight,
-			EndHeight:    params.EndHeight,
+			Network:           params.NetworkType(),
+			PollInterval:      pollInterval,
+			PollFallback:      m.cfg.PollFallback,
+			StartHeight:       params.StartHeight,
+			EndHeight:         params.EndHeight,
+			ConfirmationDepth: depth,
 		},
 	}, nil
 }
@@ -224,3 +592,100 @@ func (m *Manager) etlLimitReached() bool {
 func (m *Manager) PathHeight(id core.PathID) (*big.Int, error) {
 	return m.etl.GetBlockHeight(id)
 }
+
+// GetSession ... Returns an inspection summary of a heuristic session
+func (m *Manager) GetSession(id core.UUID) (*models.SessionSummary, error) {
+	cfg, err := m.eng.GetSessionConfig(id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := m.alert.GetAlertPolicy(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SessionSummary{
+		ID:            id.String(),
+		HeuristicType: cfg.HeuristicType.String(),
+		Network:       cfg.Network.String(),
+		PathID:        cfg.PathID.UUID.String(),
+		Params:        cfg.Params.Bytes(),
+		AlertPolicy:   policy,
+	}, nil
+}
+
+// GetSessions ... Returns inspection summaries of all heuristic sessions
+func (m *Manager) GetSessions() ([]*models.SessionSummary, error) {
+	ids := m.eng.GetSessions()
+	summaries := make([]*models.SessionSummary, len(ids))
+
+	for i, id := range ids {
+		summary, err := m.GetSession(id)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries[i] = summary
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ID < summaries[j].ID
+	})
+
+	return summaries, nil
+}
+
+// GetPath ... Returns an inspection summary of an ETL path
+func (m *Manager) GetPath(id core.UUID) (*models.PathSummary, error) {
+	info, err := m.etl.GetPath(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.pathSummary(info), nil
+}
+
+// GetPaths ... Returns inspection summaries of all ETL paths
+func (m *Manager) GetPaths() []*models.PathSummary {
+	infos := m.etl.GetPaths()
+	summaries := make([]*models.PathSummary, len(infos))
+
+	for i, info := range infos {
+		summaries[i] = m.pathSummary(info)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ID < summaries[j].ID
+	})
+
+	return summaries
+}
+
+// pathSummary ... Builds an inspection summary for a path
+func (m *Manager) pathSummary(info *core.PathInfo) *models.PathSummary {
+	id := info.ID
+
+	processes := make([]models.ProcessSummary, len(info.Processes))
+	for i, pID := range info.Processes {
+		processes[i] = models.ProcessSummary{
+			ID:   pID.String(),
+			Type: pID.Identifier(),
+		}
+	}
+
+	sessionIDs := m.eng.GetPathSessions(id)
+	sessions := make([]string, len(sessionIDs))
+	for i, sID := range sessionIDs {
+		sessions[i] = sID.String()
+	}
+
+	return &models.PathSummary{
+		ID:          id.UUID.String(),
+		Network:     id.NetworkType().String(),
+		State:       info.State,
+		BlockHeight: info.Height,
+		Processes:   processes,
+		Sessions:    sessions,
+	}
+}
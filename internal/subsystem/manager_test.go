@@ -3,12 +3,15 @@ package subsystem_test
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/base-org/pessimism/internal/api/models"
 	"github.com/base-org/pessimism/internal/core"
 	"github.com/base-org/pessimism/internal/engine/heuristic"
 	"github.com/base-org/pessimism/internal/mocks"
+	"github.com/base-org/pessimism/internal/session"
 	"github.com/base-org/pessimism/internal/subsystem"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +22,8 @@ func testErr() error {
 }
 
 type testSuite struct {
-	sys *subsystem.Manager
+	sys      *subsystem.Manager
+	sessions session.Store
 
 	mockETL   *mocks.MockETL
 	mockENG   *mocks.EngineManager
@@ -34,13 +38,19 @@ func createTestSuite(t *testing.T) *testSuite {
 	engMock := mocks.NewEngineManager(ctrl)
 	alrtMock := mocks.NewAlertManager(ctrl)
 	cfg := &subsystem.Config{
-		MaxPathCount: 10,
+		MaxPathCount:    10,
+		L1PollInterval:  5000,
+		PollFallback:    true,
+		L1Confirmations: 5,
+		MaxPathLag:      10,
 	}
 
-	sys := subsystem.NewManager(context.Background(), cfg, etlMock, engMock, alrtMock)
+	sessions := session.NewMemStore()
+	sys := subsystem.NewManager(context.Background(), cfg, etlMock, engMock, alrtMock, sessions)
 
 	return &testSuite{
 		sys:       sys,
+		sessions:  sessions,
 		mockETL:   etlMock,
 		mockENG:   engMock,
 		mockAlert: alrtMock,
@@ -259,6 +269,623 @@ func TestRunHeuristic(t *testing.T) {
 	}
 }
 
+func TestStopHeuristic(t *testing.T) {
+	id := core.NewUUID()
+	pathID := core.PathID{}
+
+	var tests = []struct {
+		name        string
+		constructor func(t *testing.T) *testSuite
+		testLogic   func(t *testing.T, ts *testSuite)
+	}{
+		{
+			name: "Failure when deleting heuristic session",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().DeleteHeuristicSession(id).
+					Return(core.PathID{}, testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.StopHeuristic(id)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Failure when removing heuristic session from alerting system",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().DeleteHeuristicSession(id).
+					Return(pathID, nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().RemoveSession(id).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.StopHeuristic(id)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success with path still in use",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().DeleteHeuristicSession(id).
+					Return(pathID, nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().RemoveSession(id).
+					Return(nil).
+					Times(1)
+
+				ts.mockENG.EXPECT().GetPathSessions(pathID).
+					Return([]core.UUID{core.NewUUID()}).
+					Times(1)
+
+				ts.mockETL.EXPECT().RemovePath(gomock.Any()).
+					Times(0)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.StopHeuristic(id)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Success with path removal",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().DeleteHeuristicSession(id).
+					Return(pathID, nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().RemoveSession(id).
+					Return(nil).
+					Times(1)
+
+				ts.mockENG.EXPECT().GetPathSessions(pathID).
+					Return([]core.UUID{}).
+					Times(1)
+
+				ts.mockETL.EXPECT().RemovePath(pathID).
+					Return(nil).
+					Times(1)
+
+				assert.NoError(t, ts.sessions.Put(&session.Record{ID: id}))
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.StopHeuristic(id)
+				assert.NoError(t, err)
+
+				_, err = ts.sessions.Get(id)
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ts := test.constructor(t)
+			test.testLogic(t, ts)
+		})
+	}
+}
+
+func TestRecordSession(t *testing.T) {
+	ts := createTestSuite(t)
+	id := core.NewUUID()
+
+	params := &models.SessionRequestParams{
+		Network:        core.Layer1.String(),
+		HeuristicType:  core.BalanceEnforcement.String(),
+		SessionParams:  map[string]interface{}{"address": "0x420"},
+		AlertingParams: &core.AlertPolicy{Dest: core.Slack.String()},
+	}
+
+	// 1. Updating an unrecorded session should not insert a record
+	update := &models.SessionRequestParams{
+		SessionParams: map[string]interface{}{"address": "0x421"},
+	}
+	assert.NoError(t, ts.sys.UpdateSessionRecord(id, update))
+
+	_, err := ts.sessions.Get(id)
+	assert.Error(t, err)
+
+	// 2. Recording a new session should insert a record
+	assert.NoError(t, ts.sys.RecordSession(id, params))
+
+	rec, err := ts.sessions.Get(id)
+	assert.NoError(t, err)
+	assert.Equal(t, *params, rec.Params)
+
+	// 3. Recording an update should only merge the provided params
+	assert.NoError(t, ts.sys.UpdateSessionRecord(id, update))
+
+	rec, err = ts.sessions.Get(id)
+	assert.NoError(t, err)
+	assert.Equal(t, core.BalanceEnforcement.String(), rec.Params.HeuristicType)
+	assert.Equal(t, "0x421", rec.Params.SessionParams["address"])
+	assert.Equal(t, params.AlertingParams, rec.Params.AlertingParams)
+
+	// 4. Session store failures other than a missing record should be returned
+	failing := subsystem.NewManager(context.Background(), &subsystem.Config{},
+		ts.mockETL, ts.mockENG, ts.mockAlert, &failingStore{Store: session.NewMemStore()})
+	assert.Error(t, failing.UpdateSessionRecord(id, update))
+}
+
+// failingStore ... Session store whose reads fail
+type failingStore struct {
+	session.Store
+}
+
+func (fs *failingStore) Get(_ core.UUID) (*session.Record, error) {
+	return nil, testErr()
+}
+
+func TestRestoreSessions(t *testing.T) {
+	ts := createTestSuite(t)
+	id := core.NewUUID()
+	pathID := core.PathID{}
+
+	assert.NoError(t, ts.sessions.Put(&session.Record{
+		ID: id,
+		Params: models.SessionRequestParams{
+			Network:       core.Layer1.String(),
+			HeuristicType: core.BalanceEnforcement.String(),
+		},
+		Height: big.NewInt(100),
+	}))
+
+	// Records that fail to restore should be skipped
+	assert.NoError(t, ts.sessions.Put(&session.Record{
+		ID: core.NewUUID(),
+		Params: models.SessionRequestParams{
+			Network:       "unknown",
+			HeuristicType: core.BalanceEnforcement.String(),
+		},
+	}))
+
+	ts.mockENG.EXPECT().GetInputType(core.BalanceEnforcement).
+		Return(core.BlockHeader, nil).
+		Times(2)
+
+	ts.mockETL.EXPECT().GetStateKey(core.BlockHeader).
+		Return(nil, false, nil).
+		Times(1)
+
+	// Restored paths should resume from their checkpoint rather than an explicit start height
+	ts.mockETL.EXPECT().CreateProcessPath(gomock.Any()).
+		DoAndReturn(func(cfg *core.PathConfig) (core.PathID, bool, error) {
+			assert.Nil(t, cfg.ClientConfig.StartHeight)
+			return pathID, false, nil
+		}).
+		Times(1)
+
+	// Paths should resume after the recorded height
+	ts.mockETL.EXPECT().SetCheckpoint(pathID, big.NewInt(100)).
+		Return(nil).
+		Times(1)
+
+	ts.mockETL.EXPECT().ActiveCount().
+		Return(0).
+		Times(1)
+
+	// Sessions should be redeployed with their original UUIDs
+	ts.mockENG.EXPECT().DeployHeuristic(gomock.Any()).
+		DoAndReturn(func(cfg *heuristic.DeployConfig) (core.UUID, error) {
+			assert.Equal(t, id, cfg.ID)
+			return cfg.ID, nil
+		}).
+		Times(1)
+
+	ts.mockAlert.EXPECT().AddSession(id, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	ts.mockETL.EXPECT().Run(pathID).
+		Return(nil).
+		Times(1)
+
+	ids, err := ts.sys.RestoreSessions()
+	assert.NoError(t, err)
+	assert.Equal(t, []core.SessionID{{HeuristicID: id, PathID: pathID}}, ids)
+
+	// Shutdown should record the path checkpoint and close the store
+	ts.mockENG.EXPECT().GetSessionConfig(id).
+		Return(&heuristic.DeployConfig{PathID: pathID}, nil).
+		Times(1)
+
+	ts.mockENG.EXPECT().GetSessionConfig(gomock.Any()).
+		Return(nil, testErr()).
+		AnyTimes()
+
+	ts.mockETL.EXPECT().GetCheckpoint(pathID).
+		Return(big.NewInt(150), nil).
+		Times(1)
+
+	ts.mockETL.EXPECT().Shutdown().Return(nil).Times(1)
+	ts.mockENG.EXPECT().Shutdown().Return(nil).Times(1)
+	ts.mockAlert.EXPECT().Shutdown().Return(nil).Times(1)
+
+	assert.NoError(t, ts.sys.Shutdown())
+
+	rec, err := ts.sessions.Get(id)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(150), rec.Height)
+}
+
+func TestUpdateHeuristic(t *testing.T) {
+	id := core.NewUUID()
+	params := core.NewSessionParams(core.Layer1)
+	policy := &core.AlertPolicy{
+		Dest: core.Slack.String(),
+	}
+
+	var tests = []struct {
+		name        string
+		constructor func(t *testing.T) *testSuite
+		testLogic   func(t *testing.T, ts *testSuite)
+	}{
+		{
+			name: "Failure when updating heuristic session",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().UpdateHeuristicSession(id, params).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.UpdateHeuristic(id, &core.SessionConfig{Params: params, AlertPolicy: policy})
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Failure when updating alert policy",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().UpdateHeuristicSession(id, params).
+					Return(nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().UpdateSession(id, policy).
+					Return(testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.UpdateHeuristic(id, &core.SessionConfig{Params: params, AlertPolicy: policy})
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Success with only alert policy",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockAlert.EXPECT().UpdateSession(id, policy).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.UpdateHeuristic(id, &core.SessionConfig{AlertPolicy: policy})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Success with params and alert policy",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().UpdateHeuristicSession(id, params).
+					Return(nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().UpdateSession(id, policy).
+					Return(nil).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				err := ts.sys.UpdateHeuristic(id, &core.SessionConfig{Params: params, AlertPolicy: policy})
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ts := test.constructor(t)
+			test.testLogic(t, ts)
+		})
+	}
+}
+
+func TestInspection(t *testing.T) {
+	id := core.NewUUID()
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+
+	params := core.NewSessionParams(core.Layer1)
+	params.SetValue(core.AddressKey, "0x420")
+
+	deployCfg := &heuristic.DeployConfig{
+		PathID:        pathID,
+		Network:       core.Layer1,
+		HeuristicType: core.BalanceEnforcement,
+		Params:        params,
+	}
+	policy := &core.AlertPolicy{
+		Dest: core.Slack.String(),
+	}
+
+	var tests = []struct {
+		name        string
+		constructor func(t *testing.T) *testSuite
+		testLogic   func(t *testing.T, ts *testSuite)
+	}{
+		{
+			name: "Failure when fetching unknown session",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().GetSessionConfig(id).
+					Return(nil, testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				summary, err := ts.sys.GetSession(id)
+				assert.Error(t, err)
+				assert.Nil(t, summary)
+			},
+		},
+		{
+			name: "Success when listing sessions",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().GetSessions().
+					Return([]core.UUID{id}).
+					Times(1)
+
+				ts.mockENG.EXPECT().GetSessionConfig(id).
+					Return(deployCfg, nil).
+					Times(1)
+
+				ts.mockAlert.EXPECT().GetAlertPolicy(id).
+					Return(policy, nil).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				summaries, err := ts.sys.GetSessions()
+				assert.NoError(t, err)
+				assert.Len(t, summaries, 1)
+
+				assert.Equal(t, id.String(), summaries[0].ID)
+				assert.Equal(t, core.BalanceEnforcement.String(), summaries[0].HeuristicType)
+				assert.Equal(t, core.Layer1.String(), summaries[0].Network)
+				assert.Equal(t, pathID.UUID.String(), summaries[0].PathID)
+				assert.Equal(t, policy, summaries[0].AlertPolicy)
+				assert.Contains(t, string(summaries[0].Params), "0x420")
+			},
+		},
+		{
+			name: "Success when listing paths",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockETL.EXPECT().GetPaths().
+					Return([]*core.PathInfo{{
+						ID:        pathID,
+						State:     "inactive",
+						Height:    big.NewInt(0),
+						Processes: []core.ProcessID{core.MakeProcessID(core.Live, core.Read, core.BlockHeader, core.Layer1)},
+					}}).
+					Times(1)
+
+				ts.mockENG.EXPECT().GetPathSessions(pathID).
+					Return([]core.UUID{id}).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				summaries := ts.sys.GetPaths()
+				assert.Len(t, summaries, 1)
+
+				assert.Equal(t, pathID.UUID.String(), summaries[0].ID)
+				assert.Equal(t, "inactive", summaries[0].State)
+				assert.Equal(t, big.NewInt(0), summaries[0].BlockHeight)
+				assert.Len(t, summaries[0].Processes, 1)
+				assert.Equal(t, []string{id.String()}, summaries[0].Sessions)
+			},
+		},
+		{
+			name: "Failure when fetching unknown path",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockETL.EXPECT().GetPath(pathID.UUID).
+					Return(nil, testErr()).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				summary, err := ts.sys.GetPath(pathID.UUID)
+				assert.Error(t, err)
+				assert.Nil(t, summary)
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			ts := test.constructor(t)
+			test.testLogic(t, ts)
+		})
+	}
+}
+
+func TestCheckPathLag(t *testing.T) {
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+
+	ts := createTestSuite(t)
+	transit := make(chan core.Alert, 2)
+
+	ts.mockETL.EXPECT().GetPaths().
+		Return([]*core.PathInfo{
+			{ID: pathID, Lag: big.NewInt(5)},
+			{ID: pathID, Lag: big.NewInt(20)},
+			{ID: pathID},
+		}).
+		Times(1)
+
+	ts.mockAlert.EXPECT().Transit().
+		Return(transit).
+		Times(1)
+
+	ts.sys.CheckPathLag()
+
+	// Only the path lagging beyond the configured max should be alerted on
+	assert.Len(t, transit, 1)
+
+	alert := <-transit
+	assert.True(t, alert.Internal)
+	assert.Equal(t, pathID, alert.PathID)
+	assert.Equal(t, pathID.UUID, alert.HeuristicID)
+	assert.Contains(t, alert.Content, "20 blocks behind")
+}
+
+func TestHandlePathHealth(t *testing.T) {
+	pathID := core.MakePathID(0, core.MakeProcessID(core.Live, 0, 0, 0),
+		core.MakeProcessID(core.Live, 0, 0, 0))
+	procID := core.MakeProcessID(core.Live, core.Read, core.BlockHeader, core.Layer1)
+	id1, id2 := core.NewUUID(), core.NewUUID()
+
+	var tests = []struct {
+		name        string
+		constructor func(t *testing.T, transit chan core.Alert) *testSuite
+		testLogic   func(t *testing.T, ts *testSuite, transit chan core.Alert)
+	}{
+		{
+			name: "Single path alert when a process is restarting",
+			constructor: func(t *testing.T, transit chan core.Alert) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().GetPathSessions(pathID).
+					Return([]core.UUID{id1, id2}).
+					Times(1)
+
+				ts.mockAlert.EXPECT().Transit().
+					Return(transit).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite, transit chan core.Alert) {
+				ts.sys.HandlePathHealth(core.PathHealthEvent{
+					ID:       pathID,
+					Process:  procID,
+					Health:   core.PathRestarting,
+					Restarts: 1,
+					Err:      testErr(),
+				})
+
+				assert.Len(t, transit, 1)
+
+				alert := <-transit
+				assert.True(t, alert.Internal)
+				assert.Equal(t, pathID.UUID, alert.HeuristicID)
+				assert.Contains(t, alert.Content, id1.String())
+				assert.Contains(t, alert.Content, id2.String())
+			},
+		},
+		{
+			name: "Session alerts and removal when a path is torn down",
+			constructor: func(t *testing.T, transit chan core.Alert) *testSuite {
+				ts := createTestSuite(t)
+
+				ts.mockENG.EXPECT().GetPathSessions(pathID).
+					Return([]core.UUID{id1, id2}).
+					Times(1)
+
+				ts.mockAlert.EXPECT().Transit().
+					Return(transit).
+					Times(2)
+
+				for _, id := range []core.UUID{id1, id2} {
+					ts.mockENG.EXPECT().DeleteHeuristicSession(id).
+						Return(pathID, nil).
+						Times(1)
+
+					ts.mockAlert.EXPECT().RemoveSession(id).
+						Return(nil).
+						Times(1)
+				}
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite, transit chan core.Alert) {
+				assert.NoError(t, ts.sessions.Put(&session.Record{ID: id1}))
+
+				ts.sys.HandlePathHealth(core.PathHealthEvent{
+					ID:       pathID,
+					Process:  procID,
+					Health:   core.PathFailed,
+					Restarts: 3,
+					Err:      testErr(),
+				})
+
+				assert.Len(t, transit, 2)
+				for _, id := range []core.UUID{id1, id2} {
+					alert := <-transit
+					assert.True(t, alert.Internal)
+					assert.Equal(t, id, alert.HeuristicID)
+					assert.Equal(t, pathID, alert.PathID)
+				}
+
+				// Durable session records are kept so that sessions are restored on restart
+				_, err := ts.sessions.Get(id1)
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d-%s", i, test.name), func(t *testing.T) {
+			transit := make(chan core.Alert, 2)
+			ts := test.constructor(t, transit)
+			test.testLogic(t, ts, transit)
+		})
+	}
+}
+
 func TestBuildPathCfg(t *testing.T) {
 
 	var tests = []struct {
@@ -330,6 +957,34 @@ func TestBuildPathCfg(t *testing.T) {
 
 				assert.Equal(t, core.Layer1, cfg.Network)
 				assert.Equal(t, core.Live, cfg.PathType)
+				assert.Equal(t, uint64(5), cfg.ClientConfig.ConfirmationDepth)
+				assert.Equal(t, 5*time.Second, cfg.ClientConfig.PollInterval)
+				assert.True(t, cfg.ClientConfig.PollFallback)
+			},
+		},
+		{
+			name: "Success with session confirmation depth override",
+			constructor: func(t *testing.T) *testSuite {
+				ts := createTestSuite(t)
+				ts.mockENG.EXPECT().GetInputType(core.BalanceEnforcement).
+					Return(core.BlockHeader, nil).
+					Times(1)
+
+				return ts
+			},
+			testLogic: func(t *testing.T, ts *testSuite) {
+				depth := uint64(12)
+				testParams := &models.SessionRequestParams{
+					Network:           core.Layer1.String(),
+					HeuristicType:     core.BalanceEnforcement.String(),
+					ConfirmationDepth: &depth,
+				}
+
+				cfg, err := ts.sys.BuildPathCfg(testParams)
+				assert.NoError(t, err)
+				assert.NotNil(t, cfg)
+
+				assert.Equal(t, depth, cfg.ClientConfig.ConfirmationDepth)
 			},
 		},
 	}